@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
@@ -27,6 +28,8 @@ func TestAccEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 		DisableTerraformPartnerID:   false,
 		// this test intentionally checks all the RP's are registered - so this is intentional
 		SkipProviderRegistration: true,
+		RetryAttempts:            autorest.DefaultRetryAttempts,
+		RetryDuration:            autorest.DefaultRetryDuration,
 	}
 	armClient, err := clients.Build(context.Background(), builder)
 	if err != nil {
@@ -109,6 +109,35 @@ func (td TestData) ImportStepFor(resourceName string, ignore ...string) resource
 	return step
 }
 
+// ImportStepIgnoringBlocks returns a Test Step which Imports the Resource, ignoring
+// the contents of the specified nested blocks (for example, Computed blocks where the
+// ordering or defaults returned from the API aren't stable)
+func (td TestData) ImportStepIgnoringBlocks(blocks ...string) resource.TestStep {
+	return td.ImportStepForIgnoringBlocks(td.ResourceName, blocks...)
+}
+
+// ImportStepForIgnoringBlocks returns a Test Step which Imports a given resource by name,
+// ignoring the contents of the specified nested blocks
+func (td TestData) ImportStepForIgnoringBlocks(resourceName string, blocks ...string) resource.TestStep {
+	return td.ImportStepFor(resourceName, nestedBlockImportIgnores(blocks)...)
+}
+
+// nestedBlockImportIgnores converts the names of nested blocks into the prefixes used
+// by ImportStateVerifyIgnore - which are matched as a prefix, so ignoring `foo` would
+// also ignore a sibling field named `foo_bar`, whereas `foo.` only matches the block
+func nestedBlockImportIgnores(blocks []string) []string {
+	ignore := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		block = strings.TrimSuffix(block, ".")
+		if block == "" {
+			continue
+		}
+
+		ignore = append(ignore, fmt.Sprintf("%s.", block))
+	}
+	return ignore
+}
+
 // RequiresImportErrorStep returns a Test Step which expects a Requires Import
 // error to be returned when running this step
 func (td TestData) RequiresImportErrorStep(configBuilder func(data TestData) string) resource.TestStep {
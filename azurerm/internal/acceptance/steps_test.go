@@ -0,0 +1,37 @@
+package acceptance
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNestedBlockImportIgnores(t *testing.T) {
+	cases := []struct {
+		blocks   []string
+		expected []string
+	}{
+		{
+			blocks:   []string{},
+			expected: []string{},
+		},
+		{
+			blocks:   []string{"child_policies"},
+			expected: []string{"child_policies."},
+		},
+		{
+			blocks:   []string{"identity.", "blob_properties.0.cors_rule"},
+			expected: []string{"identity.", "blob_properties.0.cors_rule."},
+		},
+		{
+			blocks:   []string{"", "."},
+			expected: []string{},
+		},
+	}
+
+	for _, v := range cases {
+		actual := nestedBlockImportIgnores(v.blocks)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
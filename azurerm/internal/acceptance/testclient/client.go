@@ -6,6 +6,7 @@ import (
 	"os"
 	"sync"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
@@ -48,6 +49,8 @@ func Build() (*clients.Client, error) {
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
 			StorageUseAzureAD:        false,
+			RetryAttempts:            autorest.DefaultRetryAttempts,
+			RetryDuration:            autorest.DefaultRetryDuration,
 		}
 		client, err := clients.Build(context.TODO(), clientBuilder)
 		if err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -22,6 +23,9 @@ type ClientBuilder struct {
 	CustomCorrelationRequestID  string
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
+	RetryAttempts               int
+	RetryDuration               time.Duration
+	MaxThrottlingDelay          time.Duration
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
@@ -131,8 +135,13 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		RetryAttempts:               builder.RetryAttempts,
+		RetryDuration:               builder.RetryDuration,
 	}
 
+	// the delay cap for throttled requests is only configurable package-wide within autorest
+	autorest.Max429Delay = builder.MaxThrottlingDelay
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("error building Client: %+v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -27,6 +28,8 @@ type ClientOptions struct {
 	StorageAuthorizer         autorest.Authorizer
 	SynapseAuthorizer         autorest.Authorizer
 
+	RetryAttempts               int
+	RetryDuration               time.Duration
 	SkipProviderReg             bool
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
@@ -42,6 +45,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+
+	c.RetryAttempts = o.RetryAttempts
+	c.RetryDuration = o.RetryDuration
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
@@ -3,9 +3,15 @@ package features
 func Default() UserFeatures {
 	return UserFeatures{
 		// NOTE: ensure all nested objects are fully populated
+		AutomationModule: AutomationModuleFeatures{
+			PreventDeletionIfReferencedByRunbooks: false,
+		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
+		CosmosDBAccount: CosmosDBAccountFeatures{
+			RecreateRegionsOnZoneRedundancyChange: false,
+		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:    true,
 			RecoverSoftDeletedKeyVaults: true,
@@ -1,7 +1,9 @@
 package features
 
 type UserFeatures struct {
+	AutomationModule       AutomationModuleFeatures
 	CognitiveAccount       CognitiveAccountFeatures
+	CosmosDBAccount        CosmosDBAccountFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
@@ -10,10 +12,18 @@ type UserFeatures struct {
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 }
 
+type AutomationModuleFeatures struct {
+	PreventDeletionIfReferencedByRunbooks bool
+}
+
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
 
+type CosmosDBAccountFeatures struct {
+	RecreateRegionsOnZoneRedundancyChange bool
+}
+
 type VirtualMachineFeatures struct {
 	DeleteOSDiskOnDeletion     bool
 	GracefulShutdown           bool
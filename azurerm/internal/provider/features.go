@@ -10,6 +10,22 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 	// NOTE: if there's only one nested field these want to be Required (since there's no point
 	//       specifying the block otherwise) - however for 2+ they should be optional
 	features := map[string]*pluginsdk.Schema{
+		// lintignore:XS003
+		"automation_module": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"prevent_deletion_if_referenced_by_runbooks": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		// lintignore:XS003
 		"cognitive_account": {
 			Type:     pluginsdk.TypeList,
@@ -26,6 +42,22 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		// lintignore:XS003
+		"cosmosdb_account": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"recreate_regions_on_zone_redundancy_change": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		"key_vault": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -160,6 +192,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 
 	val := input[0].(map[string]interface{})
 
+	if raw, ok := val["automation_module"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			automationModuleRaw := items[0].(map[string]interface{})
+			if v, ok := automationModuleRaw["prevent_deletion_if_referenced_by_runbooks"]; ok {
+				features.AutomationModule.PreventDeletionIfReferencedByRunbooks = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["cognitive_account"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -170,6 +212,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["cosmosdb_account"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			cosmosDBAccountRaw := items[0].(map[string]interface{})
+			if v, ok := cosmosDBAccountRaw["recreate_regions_on_zone_redundancy_change"]; ok {
+				features.CosmosDBAccount.RecreateRegionsOnZoneRedundancyChange = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["key_vault"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -18,9 +18,15 @@ func TestExpandFeatures(t *testing.T) {
 			Name:  "Empty Block",
 			Input: []interface{}{},
 			Expected: features.UserFeatures{
+				AutomationModule: features.AutomationModuleFeatures{
+					PreventDeletionIfReferencedByRunbooks: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				CosmosDBAccount: features.CosmosDBAccountFeatures{
+					RecreateRegionsOnZoneRedundancyChange: false,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeleteOnDestroy:    true,
 					RecoverSoftDeletedKeyVaults: true,
@@ -49,11 +55,21 @@ func TestExpandFeatures(t *testing.T) {
 			Name: "Complete Enabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"automation_module": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_referenced_by_runbooks": true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"cosmosdb_account": []interface{}{
+						map[string]interface{}{
+							"recreate_regions_on_zone_redundancy_change": true,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy":    true,
@@ -91,9 +107,15 @@ func TestExpandFeatures(t *testing.T) {
 				},
 			},
 			Expected: features.UserFeatures{
+				AutomationModule: features.AutomationModuleFeatures{
+					PreventDeletionIfReferencedByRunbooks: true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				CosmosDBAccount: features.CosmosDBAccountFeatures{
+					RecreateRegionsOnZoneRedundancyChange: true,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeleteOnDestroy:    true,
 					RecoverSoftDeletedKeyVaults: true,
@@ -122,11 +144,21 @@ func TestExpandFeatures(t *testing.T) {
 			Name: "Complete Disabled",
 			Input: []interface{}{
 				map[string]interface{}{
+					"automation_module": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_referenced_by_runbooks": false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"cosmosdb_account": []interface{}{
+						map[string]interface{}{
+							"recreate_regions_on_zone_redundancy_change": false,
+						},
+					},
 					"key_vault": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy":    false,
@@ -164,9 +196,15 @@ func TestExpandFeatures(t *testing.T) {
 				},
 			},
 			Expected: features.UserFeatures{
+				AutomationModule: features.AutomationModuleFeatures{
+					PreventDeletionIfReferencedByRunbooks: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				CosmosDBAccount: features.CosmosDBAccountFeatures{
+					RecreateRegionsOnZoneRedundancyChange: false,
+				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeleteOnDestroy:    false,
 					RecoverSoftDeletedKeyVaults: false,
@@ -202,6 +240,71 @@ func TestExpandFeatures(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAutomationModule(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"automation_module": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AutomationModule: features.AutomationModuleFeatures{
+					PreventDeletionIfReferencedByRunbooks: false,
+				},
+			},
+		},
+		{
+			Name: "Prevent Deletion Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"automation_module": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_referenced_by_runbooks": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AutomationModule: features.AutomationModuleFeatures{
+					PreventDeletionIfReferencedByRunbooks: true,
+				},
+			},
+		},
+		{
+			Name: "Prevent Deletion Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"automation_module": []interface{}{
+						map[string]interface{}{
+							"prevent_deletion_if_referenced_by_runbooks": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AutomationModule: features.AutomationModuleFeatures{
+					PreventDeletionIfReferencedByRunbooks: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AutomationModule, testCase.Expected.AutomationModule) {
+			t.Fatalf("Expected %+v but got %+v", result.AutomationModule, testCase.Expected.AutomationModule)
+		}
+	}
+}
+
 func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -267,6 +370,71 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesCosmosDBAccount(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"cosmosdb_account": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				CosmosDBAccount: features.CosmosDBAccountFeatures{
+					RecreateRegionsOnZoneRedundancyChange: false,
+				},
+			},
+		},
+		{
+			Name: "Recreate Regions Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"cosmosdb_account": []interface{}{
+						map[string]interface{}{
+							"recreate_regions_on_zone_redundancy_change": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				CosmosDBAccount: features.CosmosDBAccountFeatures{
+					RecreateRegionsOnZoneRedundancyChange: true,
+				},
+			},
+		},
+		{
+			Name: "Recreate Regions Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"cosmosdb_account": []interface{}{
+						map[string]interface{}{
+							"recreate_regions_on_zone_redundancy_change": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				CosmosDBAccount: features.CosmosDBAccountFeatures{
+					RecreateRegionsOnZoneRedundancyChange: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.CosmosDBAccount, testCase.Expected.CosmosDBAccount) {
+			t.Fatalf("Expected %+v but got %+v", result.CosmosDBAccount, testCase.Expected.CosmosDBAccount)
+		}
+	}
+}
+
 func TestExpandFeaturesKeyVault(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -121,6 +125,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The Tenant ID which should be used.",
 			},
 
+			"alias_environment_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_]+$`), "`alias_environment_prefix` may only contain letters, numbers and underscores"),
+				Description:  "A prefix used to source the credentials for this Provider block from `ARM_ALIAS_{PREFIX}_*` Environment Variables, rather than the `ARM_*` Environment Variables.",
+			},
+
 			"auxiliary_tenant_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -228,6 +239,31 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use AzureAD to access the Storage Data Plane API's?",
 			},
+
+			// Retry behaviour
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc("ARM_RETRY_MAX_ATTEMPTS", autorest.DefaultRetryAttempts),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of times a request failing with a retryable status code (such as a 5xx) should be retried. Throttled (429) requests are retried until the operation times out.",
+			},
+
+			"retry_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc("ARM_RETRY_DELAY_SECONDS", int(autorest.DefaultRetryDuration.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The initial number of seconds to wait between retries, which increases exponentially. A `Retry-After` header returned by the API takes precedence.",
+			},
+
+			"retry_max_throttling_delay_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  envIntDefaultFunc("ARM_RETRY_MAX_THROTTLING_DELAY_SECONDS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of seconds to wait between retries of a throttled (429) request which has no `Retry-After` header. Defaults to `0`, meaning no limit.",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -270,17 +306,19 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			metadataHost = v
 		}
 
+		aliasPrefix := d.Get("alias_environment_prefix").(string)
+
 		builder := &authentication.Builder{
-			SubscriptionID:     d.Get("subscription_id").(string),
-			ClientID:           d.Get("client_id").(string),
-			ClientSecret:       d.Get("client_secret").(string),
-			TenantID:           d.Get("tenant_id").(string),
+			SubscriptionID:     aliasedCredential(d, aliasPrefix, "subscription_id"),
+			ClientID:           aliasedCredential(d, aliasPrefix, "client_id"),
+			ClientSecret:       aliasedCredential(d, aliasPrefix, "client_secret"),
+			TenantID:           aliasedCredential(d, aliasPrefix, "tenant_id"),
 			AuxiliaryTenantIDs: auxTenants,
 			Environment:        d.Get("environment").(string),
 			MetadataHost:       metadataHost,
 			MsiEndpoint:        d.Get("msi_endpoint").(string),
-			ClientCertPassword: d.Get("client_certificate_password").(string),
-			ClientCertPath:     d.Get("client_certificate_path").(string),
+			ClientCertPassword: aliasedCredential(d, aliasPrefix, "client_certificate_password"),
+			ClientCertPath:     aliasedCredential(d, aliasPrefix, "client_certificate_path"),
 
 			// Feature Toggles
 			SupportsClientCertAuth:         true,
@@ -315,6 +353,9 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			RetryAttempts:               d.Get("retry_max_attempts").(int),
+			RetryDuration:               time.Duration(d.Get("retry_delay_seconds").(int)) * time.Second,
+			MaxThrottlingDelay:          time.Duration(d.Get("retry_max_throttling_delay_seconds").(int)) * time.Second,
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
@@ -355,6 +396,23 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	}
 }
 
+// aliasedCredential returns the value of the credential field `field`, preferring the
+// `ARM_ALIAS_{PREFIX}_{FIELD}` Environment Variable when an alias prefix is configured
+// so that aliased Provider blocks can authenticate using a distinct set of credentials
+func aliasedCredential(d *schema.ResourceData, prefix, field string) string {
+	if prefix != "" {
+		if v := os.Getenv(aliasEnvironmentVariableName(prefix, field)); v != "" {
+			return v
+		}
+	}
+
+	return d.Get(field).(string)
+}
+
+func aliasEnvironmentVariableName(prefix, field string) string {
+	return fmt.Sprintf("ARM_ALIAS_%s_%s", strings.ToUpper(prefix), strings.ToUpper(field))
+}
+
 const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers are registered.
 
 Terraform automatically attempts to register the Resource Providers it supports to
@@ -376,3 +434,21 @@ More information on the "skip_provider_registration" flag can be found here:
 https://www.terraform.io/docs/providers/azurerm/index.html#skip_provider_registration
 
 Original Error: %s`
+
+// envIntDefaultFunc is the equivalent of schema.EnvDefaultFunc for integer fields, since environment variables
+// are always strings
+func envIntDefaultFunc(key string, defaultValue int) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		v := os.Getenv(key)
+		if v == "" {
+			return defaultValue, nil
+		}
+
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the environment variable %q as an integer: %+v", key, err)
+		}
+
+		return i, nil
+	}
+}
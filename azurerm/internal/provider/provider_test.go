@@ -2,8 +2,11 @@ package provider
 
 import (
 	"fmt"
+	"os"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestProvider(t *testing.T) {
@@ -87,3 +90,53 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
+
+func TestAliasedCredential(t *testing.T) {
+	os.Setenv("ARM_ALIAS_SECURITY_CLIENT_ID", "aliased-client-id")
+	defer os.Unsetenv("ARM_ALIAS_SECURITY_CLIENT_ID")
+
+	provider := TestAzureProvider()
+	d := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		"client_id": "configured-client-id",
+		"tenant_id": "configured-tenant-id",
+	})
+
+	testData := []struct {
+		Name     string
+		Prefix   string
+		Field    string
+		Expected string
+	}{
+		{
+			Name:     "No Prefix",
+			Prefix:   "",
+			Field:    "client_id",
+			Expected: "configured-client-id",
+		},
+		{
+			Name:     "Prefix with Environment Variable",
+			Prefix:   "security",
+			Field:    "client_id",
+			Expected: "aliased-client-id",
+		},
+		{
+			Name:     "Prefix without Environment Variable",
+			Prefix:   "security",
+			Field:    "tenant_id",
+			Expected: "configured-tenant-id",
+		},
+		{
+			Name:     "Unrelated Prefix",
+			Prefix:   "network",
+			Field:    "client_id",
+			Expected: "configured-client-id",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+		if actual := aliasedCredential(d, v.Prefix, v.Field); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+// WaitForConfiguration defines the state a resource should reach after being Created/Updated
+// before Terraform considers it ready, as configured in the `wait_for` block
+type WaitForConfiguration struct {
+	// State is the state which the resource must reach (compared case-insensitively)
+	State string
+
+	// Timeout is how long to wait for this state, when zero the remaining
+	// time from the Create/Update timeout is used
+	Timeout time.Duration
+}
+
+// WaitForSchema returns the schema for the optional `wait_for` block, which allows users to
+// wait for a resource to reach a given state after it's been provisioned, for resources
+// where this can take some time beyond the API reporting the operation as completed
+func WaitForSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"state": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateWaitForTimeout,
+				},
+			},
+		},
+	}
+}
+
+// ExpandWaitFor expands the `wait_for` block into a WaitForConfiguration, returning nil
+// when the block isn't specified
+func ExpandWaitFor(input []interface{}) (*WaitForConfiguration, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := WaitForConfiguration{
+		State: raw["state"].(string),
+	}
+
+	if v := raw["timeout"].(string); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `wait_for.0.timeout` %q: %+v", v, err)
+		}
+		output.Timeout = timeout
+	}
+
+	return &output, nil
+}
+
+// WaitForConfiguredState waits for the resource to reach the state configured in the `wait_for`
+// block (if specified) - where refresh returns the current state of the resource
+func WaitForConfiguredState(ctx context.Context, d *pluginsdk.ResourceData, refresh pluginsdk.StateRefreshFunc) error {
+	config, err := ExpandWaitFor(d.Get("wait_for").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return nil
+	}
+
+	return config.Wait(ctx, refresh)
+}
+
+// WaitForConfiguredState waits for the resource to reach the state configured in the `wait_for`
+// block (if specified) - where refresh returns the current state of the resource
+func (rmd ResourceMetaData) WaitForConfiguredState(ctx context.Context, refresh pluginsdk.StateRefreshFunc) error {
+	return WaitForConfiguredState(ctx, rmd.ResourceData, refresh)
+}
+
+// Wait polls refresh until the resource reaches the configured State
+func (c WaitForConfiguration) Wait(ctx context.Context, refresh pluginsdk.StateRefreshFunc) error {
+	timeout := c.Timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline)
+		if timeout == 0 || timeout > remaining {
+			timeout = remaining
+		}
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("waiting for state %q: no time remaining", c.State)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		// any other state is treated as pending, since the possible states differ between resources
+		Pending: []string{},
+		Target:  []string{c.State},
+		Refresh: func() (interface{}, string, error) {
+			result, state, err := refresh()
+			if err != nil {
+				return result, state, err
+			}
+
+			// match the configured state case-insensitively
+			if strings.EqualFold(state, c.State) {
+				state = c.State
+			}
+
+			return result, state, nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for state %q: %+v", c.State, err)
+	}
+
+	return nil
+}
+
+func validateWaitForTimeout(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	timeout, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as `30m` or `1h`: %+v", k, err)}
+	}
+
+	if timeout <= 0 {
+		return nil, []error{fmt.Errorf("%q must be greater than zero", k)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestExpandWaitFor(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *WaitForConfiguration
+		Error    bool
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "State Only",
+			Input: []interface{}{
+				map[string]interface{}{
+					"state":   "Running",
+					"timeout": "",
+				},
+			},
+			Expected: &WaitForConfiguration{
+				State: "Running",
+			},
+		},
+		{
+			Name: "State and Timeout",
+			Input: []interface{}{
+				map[string]interface{}{
+					"state":   "Succeeded",
+					"timeout": "1h30m",
+				},
+			},
+			Expected: &WaitForConfiguration{
+				State:   "Succeeded",
+				Timeout: 90 * time.Minute,
+			},
+		},
+		{
+			Name: "Invalid Timeout",
+			Input: []interface{}{
+				map[string]interface{}{
+					"state":   "Succeeded",
+					"timeout": "soon",
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ExpandWaitFor(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if v.Expected == nil {
+			if actual != nil {
+				t.Fatalf("expected nil but got %+v", *actual)
+			}
+			continue
+		}
+
+		if actual == nil || *actual != *v.Expected {
+			t.Fatalf("expected %+v but got %+v", *v.Expected, actual)
+		}
+	}
+}
+
+func TestValidateWaitForTimeout(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "10",
+			Valid: false,
+		},
+		{
+			Input: "-5m",
+			Valid: false,
+		},
+		{
+			Input: "30m",
+			Valid: true,
+		},
+		{
+			Input: "1h15m",
+			Valid: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := validateWaitForTimeout(v.Input, "timeout")
+		actual := len(errors) == 0
+		if v.Valid != actual {
+			t.Fatalf("expected %t but got %t", v.Valid, actual)
+		}
+	}
+}
+
+func TestWaitForConfigurationWait(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	config := WaitForConfiguration{
+		State: "Running",
+	}
+
+	// the state is matched case-insensitively
+	if err := config.Wait(ctx, func() (interface{}, string, error) {
+		return struct{}{}, "running", nil
+	}); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	// errors from the refresh function are returned
+	if err := config.Wait(ctx, func() (interface{}, string, error) {
+		return nil, "", fmt.Errorf("boom")
+	}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -299,6 +299,10 @@ func flattenDataSourceApiManagementHostnameConfigurations(input *[]apimanagement
 			output["key_vault_id"] = *config.KeyVaultID
 		}
 
+		if config.IdentityClientID != nil {
+			output["ssl_keyvault_identity_client_id"] = *config.IdentityClientID
+		}
+
 		switch strings.ToLower(string(config.Type)) {
 		case strings.ToLower(string(apimanagement.HostnameTypeProxy)):
 			// only set SSL binding for proxy types
@@ -406,6 +410,11 @@ func apiManagementDataSourceHostnameSchema() map[string]*pluginsdk.Schema {
 			Computed: true,
 		},
 
+		"ssl_keyvault_identity_client_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
 		"negotiate_client_certificate": {
 			Type:     pluginsdk.TypeBool,
 			Computed: true,
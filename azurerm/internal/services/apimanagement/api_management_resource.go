@@ -503,6 +503,40 @@ func resourceApiManagementService() *pluginsdk.Resource {
 				},
 			},
 
+			"delegation": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subscriptions_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"user_registration_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"url": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+
+						"validation_key": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+					},
+				},
+			},
+
 			"zones": azure.SchemaZones(),
 
 			"gateway_url": {
@@ -758,6 +792,21 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
+	delegationSettingsRaw := d.Get("delegation").([]interface{})
+	if sku.Name == apimanagement.SkuTypeConsumption && len(delegationSettingsRaw) > 0 {
+		return fmt.Errorf("`delegation` is not supported for sku tier `Consumption`")
+	}
+	if sku.Name != apimanagement.SkuTypeConsumption && d.HasChange("delegation") {
+		delegationSettings, err := expandApiManagementDelegationSettings(delegationSettingsRaw)
+		if err != nil {
+			return err
+		}
+		delegationClient := meta.(*clients.Client).ApiManagement.DelegationSettingsClient
+		if _, err := delegationClient.CreateOrUpdate(ctx, resourceGroup, name, *delegationSettings, ""); err != nil {
+			return fmt.Errorf("setting Delegation settings for API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	policyClient := meta.(*clients.Client).ApiManagement.PolicyClient
 	policiesRaw := d.Get("policy").([]interface{})
 	policy, err := expandApiManagementPolicies(policiesRaw)
@@ -801,6 +850,7 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 	client := meta.(*clients.Client).ApiManagement.ServiceClient
 	signInClient := meta.(*clients.Client).ApiManagement.SignInClient
 	signUpClient := meta.(*clients.Client).ApiManagement.SignUpClient
+	delegationClient := meta.(*clients.Client).ApiManagement.DelegationSettingsClient
 	tenantAccessClient := meta.(*clients.Client).ApiManagement.TenantAccessClient
 	environment := meta.(*clients.Client).Account.Environment
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -922,9 +972,19 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		if err := d.Set("sign_up", flattenApiManagementSignUpSettings(signUpSettings)); err != nil {
 			return fmt.Errorf("setting `sign_up`: %+v", err)
 		}
+
+		delegationSettings, err := delegationClient.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("retrieving Delegation Settings for API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err := d.Set("delegation", flattenApiManagementDelegationSettings(d, delegationSettings)); err != nil {
+			return fmt.Errorf("setting `delegation`: %+v", err)
+		}
 	} else {
 		d.Set("sign_in", []interface{}{})
 		d.Set("sign_up", []interface{}{})
+		d.Set("delegation", []interface{}{})
 	}
 
 	if resp.Sku.Name != apimanagement.SkuTypeConsumption {
@@ -1070,6 +1130,11 @@ func expandApiManagementCommonHostnameConfiguration(input map[string]interface{}
 			output.KeyVaultID = utils.String(v.(string))
 		}
 	}
+	if v, ok := input["ssl_keyvault_identity_client_id"]; ok {
+		if v.(string) != "" {
+			output.IdentityClientID = utils.String(v.(string))
+		}
+	}
 
 	if v, ok := input["negotiate_client_certificate"]; ok {
 		output.NegotiateClientCertificate = utils.Bool(v.(bool))
@@ -1110,6 +1175,10 @@ func flattenApiManagementHostnameConfigurations(input *[]apimanagement.HostnameC
 			output["key_vault_id"] = *config.KeyVaultID
 		}
 
+		if config.IdentityClientID != nil {
+			output["ssl_keyvault_identity_client_id"] = *config.IdentityClientID
+		}
+
 		var configType string
 		switch strings.ToLower(string(config.Type)) {
 		case strings.ToLower(string(apimanagement.HostnameTypeProxy)):
@@ -1655,6 +1724,94 @@ func flattenApiManagementSignUpSettings(input apimanagement.PortalSignupSettings
 	}
 }
 
+func expandApiManagementDelegationSettings(input []interface{}) (*apimanagement.PortalDelegationSettings, error) {
+	// when the block is removed delegation is disabled and the URL cleared, which is the default for a new service
+	if len(input) == 0 || input[0] == nil {
+		return &apimanagement.PortalDelegationSettings{
+			PortalDelegationSettingsProperties: &apimanagement.PortalDelegationSettingsProperties{
+				URL: utils.String(""),
+				Subscriptions: &apimanagement.SubscriptionsDelegationSettingsProperties{
+					Enabled: utils.Bool(false),
+				},
+				UserRegistration: &apimanagement.RegistrationDelegationSettingsProperties{
+					Enabled: utils.Bool(false),
+				},
+			},
+		}, nil
+	}
+
+	vs := input[0].(map[string]interface{})
+	subscriptionsEnabled := vs["subscriptions_enabled"].(bool)
+	userRegistrationEnabled := vs["user_registration_enabled"].(bool)
+	url := vs["url"].(string)
+	validationKey := vs["validation_key"].(string)
+
+	if (subscriptionsEnabled || userRegistrationEnabled) && (url == "" || validationKey == "") {
+		return nil, fmt.Errorf("`url` and `validation_key` must be specified within the `delegation` block when `subscriptions_enabled` or `user_registration_enabled` is set to `true`")
+	}
+
+	props := apimanagement.PortalDelegationSettingsProperties{
+		Subscriptions: &apimanagement.SubscriptionsDelegationSettingsProperties{
+			Enabled: utils.Bool(subscriptionsEnabled),
+		},
+		UserRegistration: &apimanagement.RegistrationDelegationSettingsProperties{
+			Enabled: utils.Bool(userRegistrationEnabled),
+		},
+	}
+	if url != "" {
+		props.URL = utils.String(url)
+	}
+	if validationKey != "" {
+		props.ValidationKey = utils.String(validationKey)
+	}
+
+	return &apimanagement.PortalDelegationSettings{
+		PortalDelegationSettingsProperties: &props,
+	}, nil
+}
+
+func flattenApiManagementDelegationSettings(d *pluginsdk.ResourceData, input apimanagement.PortalDelegationSettings) []interface{} {
+	subscriptionsEnabled := false
+	userRegistrationEnabled := false
+	url := ""
+
+	if props := input.PortalDelegationSettingsProperties; props != nil {
+		if props.Subscriptions != nil && props.Subscriptions.Enabled != nil {
+			subscriptionsEnabled = *props.Subscriptions.Enabled
+		}
+		if props.UserRegistration != nil && props.UserRegistration.Enabled != nil {
+			userRegistrationEnabled = *props.UserRegistration.Enabled
+		}
+		if props.URL != nil {
+			url = *props.URL
+		}
+	}
+
+	// delegation is disabled by default, in which case there's nothing to flatten
+	if !subscriptionsEnabled && !userRegistrationEnabled && url == "" {
+		return []interface{}{}
+	}
+
+	// the validation key isn't returned from the API, so we pull it from the state if it's present
+	validationKey := ""
+	if existing, ok := d.GetOk("delegation"); ok {
+		existingVs := existing.([]interface{})
+		if len(existingVs) > 0 && existingVs[0] != nil {
+			existingV := existingVs[0].(map[string]interface{})
+			validationKey = existingV["validation_key"].(string)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"subscriptions_enabled":     subscriptionsEnabled,
+			"user_registration_enabled": userRegistrationEnabled,
+			"url":                       url,
+			"validation_key":            validationKey,
+		},
+	}
+}
+
 func expandApiManagementPolicies(input []interface{}) (*apimanagement.PolicyContract, error) {
 	if len(input) == 0 || input[0] == nil {
 		return nil, nil
@@ -176,6 +176,40 @@ func TestAccApiManagement_signInSignUpSettings(t *testing.T) {
 	})
 }
 
+func TestAccApiManagement_delegationSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management", "test")
+	r := ApiManagementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.delegationSettings(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delegation.0.subscriptions_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("delegation.0.user_registration_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("delegation.0.validation_key"),
+		{
+			Config: r.delegationSettings(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delegation.0.subscriptions_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("delegation.0.user_registration_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("delegation.0.validation_key"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delegation.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagement_policy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management", "test")
 	r := ApiManagementResource{}
@@ -295,6 +329,22 @@ func TestAccApiManagement_identitySystemAssignedUpdateHostnameConfigurationsVers
 	})
 }
 
+func TestAccApiManagement_identityUserAssignedHostnameConfigurationsKeyVaultId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management", "test")
+	r := ApiManagementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityUserAssignedHostnameConfigurationsKeyVaultId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hostname_configuration.0.proxy.0.ssl_keyvault_identity_client_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagement_identitySystemAssignedUpdateHostnameConfigurationsVersionlessKeyVaultId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management", "test")
 	r := ApiManagementResource{}
@@ -896,6 +946,36 @@ resource "azurerm_api_management" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ApiManagementResource) delegationSettings(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku_name = "Developer_1"
+
+  delegation {
+    subscriptions_enabled     = %t
+    user_registration_enabled = %t
+    url                       = "https://www.example.com/delegation"
+    validation_key            = base64encode("acctest-delegation-key")
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enabled, enabled)
+}
+
 func (ApiManagementResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1653,6 +1733,125 @@ resource "azurerm_api_management" "test" {
 `, r.identitySystemAssignedUpdateHostnameConfigurationsTemplate(data), data.RandomInteger)
 }
 
+func (ApiManagementResource) identityUserAssignedHostnameConfigurationsKeyVaultId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestKV-%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_key_vault_access_policy" "test" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+  certificate_permissions = [
+    "Create",
+    "Delete",
+    "Get",
+    "Import",
+    "List",
+    "Update",
+    "Purge",
+  ]
+  secret_permissions = [
+    "Delete",
+    "Get",
+    "List",
+    "Purge",
+  ]
+}
+
+resource "azurerm_key_vault_access_policy" "test2" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_user_assigned_identity.test.tenant_id
+  object_id    = azurerm_user_assigned_identity.test.principal_id
+  secret_permissions = [
+    "Get",
+    "List",
+  ]
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  depends_on   = [azurerm_key_vault_access_policy.test]
+  name         = "acctestKVCert-%[1]d"
+  key_vault_id = azurerm_key_vault.test.id
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+    x509_certificate_properties {
+      extended_key_usage = ["1.3.6.1.5.5.7.3.1"]
+      key_usage = [
+        "digitalSignature",
+        "keyEncipherment",
+      ]
+      subject_alternative_names {
+        dns_names = ["api.pluginsdk.io"]
+      }
+      subject            = "CN=api.pluginsdk.io"
+      validity_in_months = 1
+    }
+  }
+}
+
+resource "azurerm_api_management" "test" {
+  depends_on          = [azurerm_key_vault_access_policy.test2]
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Developer_1"
+
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+
+  hostname_configuration {
+    proxy {
+      host_name                       = "api.pluginsdk.io"
+      key_vault_id                    = azurerm_key_vault_certificate.test.secret_id
+      ssl_keyvault_identity_client_id = azurerm_user_assigned_identity.test.client_id
+      default_ssl_binding             = true
+      negotiate_client_certificate    = false
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (ApiManagementResource) consumption(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
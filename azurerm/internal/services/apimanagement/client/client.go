@@ -18,6 +18,7 @@ type Client struct {
 	BackendClient              *apimanagement.BackendClient
 	CacheClient                *apimanagement.CacheClient
 	CertificatesClient         *apimanagement.CertificateClient
+	DelegationSettingsClient   *apimanagement.DelegationSettingsClient
 	DiagnosticClient           *apimanagement.DiagnosticClient
 	EmailTemplateClient        *apimanagement.EmailTemplateClient
 	GatewayClient              *apimanagement.GatewayClient
@@ -78,6 +79,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	certificatesClient := apimanagement.NewCertificateClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&certificatesClient.Client, o.ResourceManagerAuthorizer)
 
+	delegationSettingsClient := apimanagement.NewDelegationSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&delegationSettingsClient.Client, o.ResourceManagerAuthorizer)
+
 	diagnosticClient := apimanagement.NewDiagnosticClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&diagnosticClient.Client, o.ResourceManagerAuthorizer)
 
@@ -154,6 +158,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		BackendClient:              &backendClient,
 		CacheClient:                &cacheClient,
 		CertificatesClient:         &certificatesClient,
+		DelegationSettingsClient:   &delegationSettingsClient,
 		DiagnosticClient:           &diagnosticClient,
 		EmailTemplateClient:        &emailTemplateClient,
 		GatewayClient:              &gatewayClient,
@@ -23,6 +23,12 @@ func apiManagementResourceHostnameSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 		},
 
+		"ssl_keyvault_identity_client_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
 		"certificate": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
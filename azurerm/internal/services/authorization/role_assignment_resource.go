@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -89,7 +90,15 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 
 			"principal_type": {
 				Type:     pluginsdk.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(authorization.Group),
+					string(authorization.MSI),
+					string(authorization.ServicePrincipal),
+					string(authorization.User),
+				}, false),
 			},
 
 			"skip_service_principal_aad_check": {
@@ -113,11 +122,58 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 			},
 
 			"condition": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				RequiredWith: []string{"condition_version"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"condition_version"},
+				ConflictsWith: []string{"condition_rule"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"condition_rule": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"condition", "condition_version"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"attribute": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^@(Resource|Request|Principal|Environment)\[.+\]$`), "`attribute` must be in the format `@Source[attribute]`, e.g. `@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]`"),
+						},
+
+						"operator": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(roleAssignmentConditionOperators(), false),
+						},
+
+						"values": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringDoesNotContainAny("'"),
+							},
+						},
+					},
+				},
 			},
 
 			"condition_version": {
@@ -130,6 +186,11 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 					"2.0",
 				}, false),
 			},
+
+			"rendered_condition": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -210,6 +271,11 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	condition := d.Get("condition").(string)
 	conditionVersion := d.Get("condition_version").(string)
 
+	if v := d.Get("condition_rule").([]interface{}); len(v) > 0 {
+		condition = expandRoleAssignmentConditionRules(v)
+		conditionVersion = "2.0"
+	}
+
 	if condition != "" && conditionVersion != "" {
 		properties.RoleAssignmentProperties.Condition = utils.String(condition)
 		properties.RoleAssignmentProperties.ConditionVersion = utils.String(conditionVersion)
@@ -222,6 +288,10 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		properties.RoleAssignmentProperties.PrincipalType = authorization.ServicePrincipal
 	}
 
+	if v := d.Get("principal_type").(string); v != "" {
+		properties.RoleAssignmentProperties.PrincipalType = authorization.PrincipalType(v)
+	}
+
 	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId)); err != nil {
 		return err
 	}
@@ -268,8 +338,13 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("principal_type", props.PrincipalType)
 		d.Set("delegated_managed_identity_resource_id", props.DelegatedManagedIdentityResourceID)
 		d.Set("description", props.Description)
-		d.Set("condition", props.Condition)
-		d.Set("condition_version", props.ConditionVersion)
+		d.Set("rendered_condition", props.Condition)
+
+		// when `condition_rule` is used the condition is rendered by the provider, so it's only exposed as `rendered_condition`
+		if v := d.Get("condition_rule").([]interface{}); len(v) == 0 {
+			d.Set("condition", props.Condition)
+			d.Set("condition_version", props.ConditionVersion)
+		}
 
 		// allows for import when role name is used (also if the role name changes a plan will show a diff)
 		if roleId := props.RoleDefinitionID; roleId != nil {
@@ -402,3 +477,68 @@ func getTenantIdBySubscriptionId(ctx context.Context, client *subscriptions.Clie
 	}
 	return *resp.TenantID, nil
 }
+
+func roleAssignmentConditionOperators() []string {
+	return []string{
+		"BoolEquals",
+		"BoolNotEquals",
+		"DateTimeEquals",
+		"DateTimeGreaterThan",
+		"DateTimeGreaterThanEquals",
+		"DateTimeLessThan",
+		"DateTimeLessThanEquals",
+		"DateTimeNotEquals",
+		"GuidEquals",
+		"GuidNotEquals",
+		"NumericEquals",
+		"NumericGreaterThan",
+		"NumericGreaterThanEquals",
+		"NumericLessThan",
+		"NumericLessThanEquals",
+		"NumericNotEquals",
+		"StringEquals",
+		"StringEqualsIgnoreCase",
+		"StringLike",
+		"StringNotEquals",
+		"StringNotEqualsIgnoreCase",
+		"StringNotLike",
+		"StringNotStartsWith",
+		"StringNotStartsWithIgnoreCase",
+		"StringStartsWith",
+		"StringStartsWithIgnoreCase",
+	}
+}
+
+// expandRoleAssignmentConditionRules renders the `condition_rule` blocks into a version 2.0 ABAC condition. Each
+// rule only applies to its own actions, all rules must be satisfied and any of the values of a rule may match
+func expandRoleAssignmentConditionRules(input []interface{}) string {
+	rules := make([]string, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		actions := make([]string, 0)
+		for _, action := range v["actions"].([]interface{}) {
+			actions = append(actions, fmt.Sprintf("!(ActionMatches{'%s'})", action.(string)))
+		}
+
+		attribute := v["attribute"].(string)
+		operator := v["operator"].(string)
+		expressions := make([]string, 0)
+		for _, value := range v["values"].([]interface{}) {
+			expressions = append(expressions, fmt.Sprintf("%s %s %s", attribute, operator, formatRoleAssignmentConditionValue(operator, value.(string))))
+		}
+
+		rules = append(rules, fmt.Sprintf("((%s) OR (%s))", strings.Join(actions, " AND "), strings.Join(expressions, " OR ")))
+	}
+
+	return strings.Join(rules, " AND ")
+}
+
+func formatRoleAssignmentConditionValue(operator, value string) string {
+	if strings.HasPrefix(operator, "String") || strings.HasPrefix(operator, "DateTime") {
+		return fmt.Sprintf("'%s'", value)
+	}
+
+	return value
+}
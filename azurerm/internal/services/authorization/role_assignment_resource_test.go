@@ -236,6 +236,24 @@ func TestAccRoleAssignment_condition(t *testing.T) {
 	})
 }
 
+func TestAccRoleAssignment_conditionRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
+	id := uuid.New().String()
+
+	r := RoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.conditionRule(id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rendered_condition").HasValue("((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'foo' OR @Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'bar'))"),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check", "condition", "condition_rule", "condition_version"),
+	})
+}
+
 func TestAccRoleAssignment_resourceScoped(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
 	id := uuid.New().String()
@@ -616,6 +634,35 @@ resource "azurerm_role_assignment" "test" {
 `, groupId)
 }
 
+func (RoleAssignmentResource) conditionRule(groupId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                 = "%s"
+  scope                = data.azurerm_subscription.primary.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = data.azurerm_client_config.test.object_id
+  principal_type       = "ServicePrincipal"
+
+  condition_rule {
+    actions   = ["Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read"]
+    attribute = "@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name]"
+    operator  = "StringEquals"
+    values    = ["foo", "bar"]
+  }
+}
+`, groupId)
+}
+
 func (RoleAssignmentResource) condition(groupId string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,148 @@
+package authorization
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmRoleDefinitionPermissions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmRoleDefinitionPermissionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"base_role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// Computed
+
+			"added_actions":            roleDefinitionPermissionsDiffSchema(),
+			"removed_actions":          roleDefinitionPermissionsDiffSchema(),
+			"added_not_actions":        roleDefinitionPermissionsDiffSchema(),
+			"removed_not_actions":      roleDefinitionPermissionsDiffSchema(),
+			"added_data_actions":       roleDefinitionPermissionsDiffSchema(),
+			"removed_data_actions":     roleDefinitionPermissionsDiffSchema(),
+			"added_not_data_actions":   roleDefinitionPermissionsDiffSchema(),
+			"removed_not_data_actions": roleDefinitionPermissionsDiffSchema(),
+		},
+	}
+}
+
+func roleDefinitionPermissionsDiffSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+func dataSourceArmRoleDefinitionPermissionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	baseId := d.Get("base_role_definition_id").(string)
+	targetId := d.Get("target_role_definition_id").(string)
+
+	base, err := client.GetByID(ctx, baseId)
+	if err != nil {
+		return fmt.Errorf("Error loading Role Definition %q: %+v", baseId, err)
+	}
+
+	target, err := client.GetByID(ctx, targetId)
+	if err != nil {
+		return fmt.Errorf("Error loading Role Definition %q: %+v", targetId, err)
+	}
+
+	basePermissions := collectRoleDefinitionPermissions(base.RoleDefinitionProperties)
+	targetPermissions := collectRoleDefinitionPermissions(target.RoleDefinitionProperties)
+
+	for _, key := range []string{"actions", "not_actions", "data_actions", "not_data_actions"} {
+		if err := d.Set("added_"+key, diffRoleDefinitionPermissions(targetPermissions[key], basePermissions[key])); err != nil {
+			return fmt.Errorf("setting `added_%s`: %+v", key, err)
+		}
+		if err := d.Set("removed_"+key, diffRoleDefinitionPermissions(basePermissions[key], targetPermissions[key])); err != nil {
+			return fmt.Errorf("setting `removed_%s`: %+v", key, err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
+
+// collectRoleDefinitionPermissions merges all permission blocks of a role definition, keyed by their schema name
+func collectRoleDefinitionPermissions(props *authorization.RoleDefinitionProperties) map[string][]string {
+	output := map[string][]string{
+		"actions":          {},
+		"not_actions":      {},
+		"data_actions":     {},
+		"not_data_actions": {},
+	}
+
+	if props == nil || props.Permissions == nil {
+		return output
+	}
+
+	for _, permission := range *props.Permissions {
+		if permission.Actions != nil {
+			output["actions"] = append(output["actions"], *permission.Actions...)
+		}
+		if permission.NotActions != nil {
+			output["not_actions"] = append(output["not_actions"], *permission.NotActions...)
+		}
+		if permission.DataActions != nil {
+			output["data_actions"] = append(output["data_actions"], *permission.DataActions...)
+		}
+		if permission.NotDataActions != nil {
+			output["not_data_actions"] = append(output["not_data_actions"], *permission.NotDataActions...)
+		}
+	}
+
+	return output
+}
+
+// diffRoleDefinitionPermissions returns the sorted operations in `from` which aren't present in `other`, operations are
+// compared case-insensitively as Azure does when evaluating them
+func diffRoleDefinitionPermissions(from, other []string) []string {
+	existing := make(map[string]bool)
+	for _, v := range other {
+		existing[strings.ToLower(v)] = true
+	}
+
+	output := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, v := range from {
+		key := strings.ToLower(v)
+		if existing[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		output = append(output, v)
+	}
+
+	sort.Strings(output)
+	return output
+}
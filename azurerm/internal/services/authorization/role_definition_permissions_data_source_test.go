@@ -0,0 +1,85 @@
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type RoleDefinitionPermissionsDataSource struct{}
+
+func TestAccRoleDefinitionPermissionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_role_definition_permissions", "test")
+	baseId := uuid.New().String()
+	targetId := uuid.New().String()
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: RoleDefinitionPermissionsDataSource{}.basic(baseId, targetId, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("added_actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("added_actions.0").HasValue("Microsoft.Resources/subscriptions/resourceGroups/write"),
+				check.That(data.ResourceName).Key("removed_actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("removed_actions.0").HasValue("Microsoft.Resources/subscriptions/resourceGroups/delete"),
+				check.That(data.ResourceName).Key("added_not_actions.#").HasValue("0"),
+				check.That(data.ResourceName).Key("removed_not_actions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("removed_not_actions.0").HasValue("Microsoft.Authorization/*/Delete"),
+			),
+		},
+	})
+}
+
+func (RoleDefinitionPermissionsDataSource) basic(baseId, targetId string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+resource "azurerm_role_definition" "base" {
+  role_definition_id = "%s"
+  name               = "acctestrd-base-%d"
+  scope              = data.azurerm_subscription.primary.id
+
+  permissions {
+    actions = [
+      "Microsoft.Resources/subscriptions/resourceGroups/read",
+      "Microsoft.Resources/subscriptions/resourceGroups/delete",
+    ]
+    not_actions = ["Microsoft.Authorization/*/Delete"]
+  }
+
+  assignable_scopes = [
+    data.azurerm_subscription.primary.id,
+  ]
+}
+
+resource "azurerm_role_definition" "target" {
+  role_definition_id = "%s"
+  name               = "acctestrd-target-%d"
+  scope              = data.azurerm_subscription.primary.id
+
+  permissions {
+    actions = [
+      "microsoft.resources/subscriptions/resourceGroups/read",
+      "Microsoft.Resources/subscriptions/resourceGroups/write",
+    ]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    data.azurerm_subscription.primary.id,
+  ]
+}
+
+data "azurerm_role_definition_permissions" "test" {
+  base_role_definition_id   = azurerm_role_definition.base.role_definition_resource_id
+  target_role_definition_id = azurerm_role_definition.target.role_definition_resource_id
+}
+`, baseId, data.RandomInteger, targetId, data.RandomInteger)
+}
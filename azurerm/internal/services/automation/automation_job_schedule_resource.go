@@ -1,6 +1,7 @@
 package automation
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -122,23 +123,17 @@ func resourceAutomationJobScheduleCreate(d *pluginsdk.ResourceData, meta interfa
 	// fix issue: https://github.com/terraform-providers/terraform-provider-azurerm/issues/7130
 	// When the runbook has some updates, it'll update all related job schedule id, so the elder job schedule will not exist
 	// We need to delete the job schedule id if exists to recreate the job schedule
-	for jsIterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, ""); jsIterator.NotDone(); err = jsIterator.NextWithContext(ctx) {
+	existingLinks, err := automationJobSchedulesForRunbookAndSchedule(ctx, client, resourceGroup, accountName, runbookName, scheduleName)
+	if err != nil {
+		return err
+	}
+	for _, existing := range existingLinks {
+		jsId, err := uuid.FromString(*existing.JobScheduleID)
 		if err != nil {
-			return fmt.Errorf("loading Automation Account %q Job Schedule List: %+v", accountName, err)
+			return fmt.Errorf("parsing job schedule Id listed by Automation Account %q Job Schedule List:%v", accountName, err)
 		}
-		if props := jsIterator.Value().JobScheduleProperties; props != nil {
-			if props.Schedule.Name != nil && *props.Schedule.Name == scheduleName && props.Runbook.Name != nil && *props.Runbook.Name == runbookName {
-				if jsIterator.Value().JobScheduleID == nil || *jsIterator.Value().JobScheduleID == "" {
-					return fmt.Errorf("job schedule Id is nil or empty listed by Automation Account %q Job Schedule List: %+v", accountName, err)
-				}
-				jsId, err := uuid.FromString(*jsIterator.Value().JobScheduleID)
-				if err != nil {
-					return fmt.Errorf("parsing job schedule Id listed by Automation Account %q Job Schedule List:%v", accountName, err)
-				}
-				if _, err := client.Delete(ctx, resourceGroup, accountName, jsId); err != nil {
-					return fmt.Errorf("deleting job schedule Id listed by Automation Account %q Job Schedule List:%v", accountName, err)
-				}
-			}
+		if _, err := client.Delete(ctx, resourceGroup, accountName, jsId); err != nil {
+			return fmt.Errorf("deleting job schedule Id listed by Automation Account %q Job Schedule List:%v", accountName, err)
 		}
 	}
 
@@ -204,12 +199,43 @@ func resourceAutomationJobScheduleRead(d *pluginsdk.ResourceData, meta interface
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error making Read request on AzureRM Automation Job Schedule '%s': %+v", jobScheduleUUID, err)
+		}
+
+		// the Job Schedule is re-created with a new ID when the Runbook is updated (or the link is re-created outside of
+		// Terraform) - so rather than creating a duplicate link, look it up using the Runbook and Schedule it links
+		runbookName := d.Get("runbook_name").(string)
+		scheduleName := d.Get("schedule_name").(string)
+		if runbookName == "" || scheduleName == "" {
 			d.SetId("")
 			return nil
 		}
 
-		return fmt.Errorf("Error making Read request on AzureRM Automation Job Schedule '%s': %+v", jobScheduleUUID, err)
+		existingLinks, err := automationJobSchedulesForRunbookAndSchedule(ctx, client, resourceGroup, accountName, runbookName, scheduleName)
+		if err != nil {
+			return err
+		}
+		if len(existingLinks) == 0 {
+			log.Printf("[DEBUG] Automation Job Schedule %q was not found (Account %q / Resource Group %q) - removing from state", jobScheduleUUID, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		if len(existingLinks) > 1 {
+			log.Printf("[WARN] found %d Automation Job Schedules linking Runbook %q to Schedule %q (Account %q / Resource Group %q) - using the first", len(existingLinks), runbookName, scheduleName, accountName, resourceGroup)
+		}
+
+		jobScheduleUUID = uuid.FromStringOrNil(*existingLinks[0].JobScheduleID)
+		resp, err = client.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
+		if err != nil {
+			return fmt.Errorf("Error making Read request on AzureRM Automation Job Schedule '%s': %+v", jobScheduleUUID, err)
+		}
+		if resp.ID == nil || *resp.ID == "" {
+			return fmt.Errorf("Cannot read Automation Job Schedule '%s' (Account %q / Resource Group %s) ID", jobScheduleUUID, accountName, resourceGroup)
+		}
+
+		log.Printf("[DEBUG] Automation Job Schedule linking Runbook %q to Schedule %q (Account %q / Resource Group %q) now has the ID %q", runbookName, scheduleName, accountName, resourceGroup, jobScheduleUUID)
+		d.SetId(*resp.ID)
 	}
 
 	d.Set("job_schedule_id", resp.JobScheduleID)
@@ -257,3 +283,27 @@ func resourceAutomationJobScheduleDelete(d *pluginsdk.ResourceData, meta interfa
 
 	return nil
 }
+
+// automationJobSchedulesForRunbookAndSchedule returns the Job Schedules within the Automation Account which link the specified Runbook and Schedule
+func automationJobSchedulesForRunbookAndSchedule(ctx context.Context, client *automation.JobScheduleClient, resourceGroup, accountName, runbookName, scheduleName string) ([]automation.JobSchedule, error) {
+	results := make([]automation.JobSchedule, 0)
+	for jsIterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, ""); jsIterator.NotDone(); err = jsIterator.NextWithContext(ctx) {
+		if err != nil {
+			return nil, fmt.Errorf("loading Automation Account %q Job Schedule List: %+v", accountName, err)
+		}
+		props := jsIterator.Value().JobScheduleProperties
+		if props == nil || props.Schedule == nil || props.Runbook == nil {
+			continue
+		}
+		if props.Schedule.Name == nil || !strings.EqualFold(*props.Schedule.Name, scheduleName) || props.Runbook.Name == nil || !strings.EqualFold(*props.Runbook.Name, runbookName) {
+			continue
+		}
+		if jsIterator.Value().JobScheduleID == nil || *jsIterator.Value().JobScheduleID == "" {
+			return nil, fmt.Errorf("job schedule Id is nil or empty listed by Automation Account %q Job Schedule List", accountName)
+		}
+
+		results = append(results, jsIterator.Value())
+	}
+
+	return results, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
 	"github.com/gofrs/uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -74,6 +75,28 @@ func TestAccAutomationJobSchedule_update(t *testing.T) {
 	})
 }
 
+func TestAccAutomationJobSchedule_recreatedOutsideOfTerraform(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_schedule", "test")
+	r := AutomationJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.recreateWithNewID),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAutomationJobSchedule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_automation_job_schedule", "test")
 	r := AutomationJobScheduleResource{}
@@ -111,6 +134,51 @@ func (t AutomationJobScheduleResource) Exists(ctx context.Context, clients *clie
 	return utils.Bool(resp.JobScheduleProperties != nil), nil
 }
 
+// recreateWithNewID re-creates the link between the Runbook and Schedule using a new ID, as happens when the Runbook is updated
+func (AutomationJobScheduleResource) recreateWithNewID(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	client := clients.Automation.JobScheduleClient
+
+	id, err := azure.ParseAzureResourceID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	jobScheduleUUID := uuid.FromStringOrNil(id.Path["jobSchedules"])
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+
+	existing, err := client.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
+	if err != nil {
+		return fmt.Errorf("retrieving Automation Job Schedule '%s' (Account %q / Resource Group %q): %+v", jobScheduleUUID, accountName, resourceGroup, err)
+	}
+
+	if _, err := client.Delete(ctx, resourceGroup, accountName, jobScheduleUUID); err != nil {
+		return fmt.Errorf("deleting Automation Job Schedule '%s' (Account %q / Resource Group %q): %+v", jobScheduleUUID, accountName, resourceGroup, err)
+	}
+
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	parameters := automation.JobScheduleCreateParameters{
+		JobScheduleCreateProperties: &automation.JobScheduleCreateProperties{
+			Schedule: &automation.ScheduleAssociationProperty{
+				Name: existing.JobScheduleProperties.Schedule.Name,
+			},
+			Runbook: &automation.RunbookAssociationProperty{
+				Name: existing.JobScheduleProperties.Runbook.Name,
+			},
+			Parameters: existing.JobScheduleProperties.Parameters,
+			RunOn:      existing.JobScheduleProperties.RunOn,
+		},
+	}
+	if _, err := client.Create(ctx, resourceGroup, accountName, newUUID, parameters); err != nil {
+		return fmt.Errorf("re-creating Automation Job Schedule '%s' (Account %q / Resource Group %q): %+v", newUUID, accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func (AutomationJobScheduleResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1,8 +1,12 @@
 package automation
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
@@ -225,6 +229,23 @@ func resourceAutomationModuleDelete(d *pluginsdk.ResourceData, meta interface{})
 	accName := id.Path["automationAccounts"]
 	name := id.Path["modules"]
 
+	if meta.(*clients.Client).Features.AutomationModule.PreventDeletionIfReferencedByRunbooks {
+		runbookClient := meta.(*clients.Client).Automation.RunbookClient
+		runbooks, err := automationRunbooksReferencingModule(ctx, runbookClient, resGroup, accName, name)
+		if err != nil {
+			return fmt.Errorf("checking for Automation Runbooks referencing Automation Module %q (Account %q / Resource Group %q): %+v", name, accName, resGroup, err)
+		}
+
+		if len(runbooks) > 0 {
+			return fmt.Errorf(`deleting Automation Module %q (Account %q / Resource Group %q): the Module is referenced by the Automation Runbooks %s.
+
+Deleting this Module will cause these Runbooks to fail - either remove the references to this
+Module from these Runbooks or, to delete the Module regardless, set the field
+"prevent_deletion_if_referenced_by_runbooks" within the "automation_module" block of the
+"features" block in the Provider to "false".`, name, accName, resGroup, strings.Join(runbooks, ", "))
+		}
+	}
+
 	resp, err := client.Delete(ctx, resGroup, accName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp) {
@@ -262,3 +283,49 @@ func expandModuleLink(d *pluginsdk.ResourceData) automation.ContentLink {
 		URI: &uri,
 	}
 }
+
+// automationRunbooksReferencingModule returns the names of the Runbooks within the Automation Account
+// whose published content imports the specified Module
+func automationRunbooksReferencingModule(ctx context.Context, client *automation.RunbookClient, resourceGroup, accountName, moduleName string) ([]string, error) {
+	// matches `Import-Module`, `#Requires -Modules` and `using module` statements which reference the module - the name
+	// mustn't be surrounded by word characters or dots, so that e.g. `Az` doesn't match `Az.Accounts`
+	moduleReference := regexp.MustCompile(fmt.Sprintf(`(?im)^\s*(import-module|#requires\s+-modules?|using\s+module)\b[^\r\n]*[^\w.]%s([^\w.]|$)`, regexp.QuoteMeta(moduleName)))
+
+	runbooks := make([]string, 0)
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Runbooks: %+v", err)
+	}
+	for iterator.NotDone() {
+		runbook := iterator.Value()
+		if runbook.Name != nil {
+			content, err := client.GetContent(ctx, resourceGroup, accountName, *runbook.Name)
+			if err != nil {
+				// Runbooks which haven't been published yet don't have any content
+				if !utils.ResponseWasNotFound(content.Response) {
+					return nil, fmt.Errorf("retrieving content for Runbook %q: %+v", *runbook.Name, err)
+				}
+			}
+
+			if content.Value != nil && *content.Value != nil {
+				buf := new(bytes.Buffer)
+				_, err = buf.ReadFrom(*content.Value)
+				// closed within the loop rather than deferred, so that the response bodies aren't held open until every Runbook has been read
+				(*content.Value).Close()
+				if err != nil {
+					return nil, fmt.Errorf("reading content for Runbook %q: %+v", *runbook.Name, err)
+				}
+
+				if moduleReference.MatchString(buf.String()) {
+					runbooks = append(runbooks, *runbook.Name)
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Runbooks: %+v", err)
+		}
+	}
+
+	return runbooks, nil
+}
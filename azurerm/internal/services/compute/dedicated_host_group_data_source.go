@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -51,6 +53,42 @@ func dataSourceDedicatedHostGroup() *pluginsdk.Resource {
 				},
 			},
 
+			"hosts": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"asset_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"allocatable_virtual_machine": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"size": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"count": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -64,7 +102,7 @@ func dataSourceDedicatedHostGroupRead(d *pluginsdk.ResourceData, meta interface{
 	name := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
 
-	resp, err := client.Get(ctx, resourceGroupName, name, "")
+	resp, err := client.Get(ctx, resourceGroupName, name, compute.InstanceView)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Dedicated Host Group %q (Resource Group %q) was not found", name, resourceGroupName)
@@ -87,9 +125,60 @@ func dataSourceDedicatedHostGroupRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("platform_fault_domain_count", platformFaultDomainCount)
 
 		d.Set("automatic_placement_enabled", props.SupportAutomaticPlacement)
+
+		if err := d.Set("hosts", flattenDedicatedHostGroupHostInstanceViews(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `hosts`: %+v", err)
+		}
 	}
 
 	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+func flattenDedicatedHostGroupHostInstanceViews(input *compute.DedicatedHostGroupInstanceView) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Hosts == nil {
+		return results
+	}
+
+	for _, host := range *input.Hosts {
+		name := ""
+		if host.Name != nil {
+			name = *host.Name
+		}
+
+		assetId := ""
+		if host.AssetID != nil {
+			assetId = *host.AssetID
+		}
+
+		allocatableVirtualMachines := make([]interface{}, 0)
+		if host.AvailableCapacity != nil && host.AvailableCapacity.AllocatableVMs != nil {
+			for _, vm := range *host.AvailableCapacity.AllocatableVMs {
+				size := ""
+				if vm.VMSize != nil {
+					size = *vm.VMSize
+				}
+
+				count := 0
+				if vm.Count != nil {
+					count = int(*vm.Count)
+				}
+
+				allocatableVirtualMachines = append(allocatableVirtualMachines, map[string]interface{}{
+					"size":  size,
+					"count": count,
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                        name,
+			"asset_id":                    assetId,
+			"allocatable_virtual_machine": allocatableVirtualMachines,
+		})
+	}
+
+	return results
+}
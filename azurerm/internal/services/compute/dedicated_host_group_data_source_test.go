@@ -28,6 +28,22 @@ func TestAccDataSourceDedicatedHostGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceDedicatedHostGroup_hosts(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dedicated_host_group", "test")
+	r := DedicatedHostGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.hosts(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("hosts.#").HasValue("1"),
+				check.That(data.ResourceName).Key("hosts.0.name").Exists(),
+				check.That(data.ResourceName).Key("hosts.0.allocatable_virtual_machine.#").Exists(),
+			),
+		},
+	})
+}
+
 func (DedicatedHostGroupDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -38,3 +54,16 @@ data "azurerm_dedicated_host_group" "test" {
 }
 `, DedicatedHostGroupResource{}.complete(data))
 }
+
+func (DedicatedHostGroupDataSource) hosts(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dedicated_host_group" "test" {
+  name                = azurerm_dedicated_host_group.test.name
+  resource_group_name = azurerm_dedicated_host_group.test.resource_group_name
+
+  depends_on = [azurerm_dedicated_host.test]
+}
+`, DedicatedHostResource{}.basic(data))
+}
@@ -17,6 +17,7 @@ var kubernetesOtherTests = map[string]func(t *testing.T){
 	"kubeletAndLinuxOSConfig":           testAccKubernetesCluster_kubeletAndLinuxOSConfig,
 	"kubeletAndLinuxOSConfig_partial":   testAccKubernetesCluster_kubeletAndLinuxOSConfigPartial,
 	"linuxProfile":                      testAccKubernetesCluster_linuxProfile,
+	"linuxProfileRotateSSHKey":          testAccKubernetesCluster_linuxProfileRotateSSHKey,
 	"nodeLabels":                        testAccKubernetesCluster_nodeLabels,
 	"nodeResourceGroup":                 testAccKubernetesCluster_nodeResourceGroup,
 	"nodePoolOther":                     testAccKubernetesCluster_nodePoolOther,
@@ -243,6 +244,34 @@ func testAccKubernetesCluster_linuxProfile(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_linuxProfileRotateSSHKey(t *testing.T) {
+	checkIfShouldRunTestsIndividually(t)
+	testAccKubernetesCluster_linuxProfileRotateSSHKey(t)
+}
+
+func testAccKubernetesCluster_linuxProfileRotateSSHKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxProfileConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.linuxProfileRotatedSSHKeyConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linux_profile.0.ssh_key.0.key_data").HasValue("ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDPPuuRSF47nUXZMZ3c7gjLq2uttLQWMCKvvvGsSJ7gA272QLzc8v6ltXO6DNoBZ9JTABN6SqPtmCd7gvk4c7LfoVbbwr+1Oi8fK58JcbCwLmYnjbOZLSB3znKCrqL60tZvk46Dcks2bQyeult3OEHB/3Uldsh/w2TDH4VK8+bIyFpAexFHP/vgKvy2RiZxnunCyKUBP4VJ5Ghip+N5e37bbZc26UPm4/pjuySgrPy8Uh5KIbtc8W5Lk8HkAnKPAZ+gTLP1oio8pKm/rc4imcinGpNjhH5F/qOvFSN0F2+0NUQyeXMm45ZpoEM2bIXtixb/HmzxijytuedcAxUEtDcF terraform@demo.tld"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesCluster_nodeLabels(t *testing.T) {
 	checkIfShouldRunTestsIndividually(t)
 	testAccKubernetesCluster_nodeLabels(t)
@@ -1058,6 +1087,44 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (KubernetesClusterResource) linuxProfileRotatedSSHKeyConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  linux_profile {
+    admin_username = "acctestuser%d"
+
+    ssh_key {
+      key_data = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDPPuuRSF47nUXZMZ3c7gjLq2uttLQWMCKvvvGsSJ7gA272QLzc8v6ltXO6DNoBZ9JTABN6SqPtmCd7gvk4c7LfoVbbwr+1Oi8fK58JcbCwLmYnjbOZLSB3znKCrqL60tZvk46Dcks2bQyeult3OEHB/3Uldsh/w2TDH4VK8+bIyFpAexFHP/vgKvy2RiZxnunCyKUBP4VJ5Ghip+N5e37bbZc26UPm4/pjuySgrPy8Uh5KIbtc8W5Lk8HkAnKPAZ+gTLP1oio8pKm/rc4imcinGpNjhH5F/qOvFSN0F2+0NUQyeXMm45ZpoEM2bIXtixb/HmzxijytuedcAxUEtDcF terraform@demo.tld"
+    }
+  }
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (KubernetesClusterResource) nodeLabelsConfig(data acceptance.TestData, labels map[string]string) string {
 	labelsSlice := make([]string, 0, len(labels))
 	for k, v := range labels {
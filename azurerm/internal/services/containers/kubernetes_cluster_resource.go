@@ -312,7 +312,6 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 						"ssh_key": {
 							Type:     pluginsdk.TypeList,
 							Required: true,
-							ForceNew: true,
 							MaxItems: 1,
 
 							Elem: &pluginsdk.Resource{
@@ -320,7 +319,6 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 									"key_data": {
 										Type:         pluginsdk.TypeString,
 										Required:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
 								},
@@ -1157,6 +1155,10 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	if d.HasChange("linux_profile") {
 		updateCluster = true
+		if d.HasChange("linux_profile.0.ssh_key.0.key_data") {
+			// the new key is only rolled out to existing nodes once the node image has been upgraded (or the nodes reimaged)
+			log.Printf("[WARN] Rotating the SSH Key for Kubernetes Cluster %q (Resource Group %q) - existing nodes will continue to use the previous key until the Node Image is upgraded", id.ManagedClusterName, id.ResourceGroup)
+		}
 		linuxProfileRaw := d.Get("linux_profile").([]interface{})
 		linuxProfile := expandKubernetesClusterLinuxProfile(linuxProfileRaw)
 		existing.ManagedClusterProperties.LinuxProfile = linuxProfile
@@ -640,6 +640,36 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		oldLocationsMap[azure.NormalizeLocation(*location.LocationName)] = location
 	}
 
+	// determine if any locations have been renamed/priority reordered and remove them - this is checked before
+	// any of the properties are updated, so that an invalid change doesn't leave the account partially updated
+	removedOne := false
+	for _, l := range newLocations {
+		if ol, ok := oldLocationsMap[*l.LocationName]; ok {
+			if *l.FailoverPriority != *ol.FailoverPriority {
+				if *l.FailoverPriority == 0 {
+					return fmt.Errorf("Cannot change the failover priority of primary Cosmos DB account %q location %s to %d (Resource Group %q)", name, *l.LocationName, *l.FailoverPriority, resourceGroup)
+				}
+				delete(oldLocationsMap, *l.LocationName)
+				removedOne = true
+				continue
+			}
+
+			// the zone redundancy of an existing location can't be changed, instead the location has to be removed and re-added
+			if ol.IsZoneRedundant != nil && *l.IsZoneRedundant != *ol.IsZoneRedundant {
+				if !meta.(*clients.Client).Features.CosmosDBAccount.RecreateRegionsOnZoneRedundancyChange {
+					return fmt.Errorf("changing `zone_redundant` for the location %s of Cosmos DB account %q (Resource Group %q) requires the location to be removed and re-added - this can be done automatically by setting `recreate_regions_on_zone_redundancy_change` within the `cosmosdb_account` block of the Provider `features` block", *l.LocationName, name, resourceGroup)
+				}
+				if *ol.FailoverPriority == 0 {
+					return fmt.Errorf("Cannot change `zone_redundant` for the primary Cosmos DB account %q location %s (Resource Group %q) since it can't be removed", name, *l.LocationName, resourceGroup)
+				}
+				log.Printf("[DEBUG] Removing location %s from Cosmos DB account %q (Resource Group %q) to change its zone redundancy", *l.LocationName, name, resourceGroup)
+				delete(oldLocationsMap, *l.LocationName)
+				removedOne = true
+				continue
+			}
+		}
+	}
+
 	publicNetworkAccess := documentdb.Enabled
 	if enabled := d.Get("public_network_access_enabled").(bool); !enabled {
 		publicNetworkAccess = documentdb.Disabled
@@ -705,21 +735,6 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	// determine if any locations have been renamed/priority reordered and remove them
-	removedOne := false
-	for _, l := range newLocations {
-		if ol, ok := oldLocationsMap[*l.LocationName]; ok {
-			if *l.FailoverPriority != *ol.FailoverPriority {
-				if *l.FailoverPriority == 0 {
-					return fmt.Errorf("Cannot change the failover priority of primary Cosmos DB account %q location %s to %d (Resource Group %q)", name, *l.LocationName, *l.FailoverPriority, resourceGroup)
-				}
-				delete(oldLocationsMap, *l.LocationName)
-				removedOne = true
-				continue
-			}
-		}
-	}
-
 	if removedOne {
 		locationsUnchanged := make([]documentdb.Location, 0, len(oldLocationsMap))
 		for _, value := range oldLocationsMap {
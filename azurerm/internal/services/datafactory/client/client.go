@@ -12,6 +12,7 @@ type Client struct {
 	LinkedServiceClient          *datafactory.LinkedServicesClient
 	ManagedVirtualNetworksClient *datafactory.ManagedVirtualNetworksClient
 	PipelinesClient              *datafactory.PipelinesClient
+	TriggerRunsClient            *datafactory.TriggerRunsClient
 	TriggersClient               *datafactory.TriggersClient
 }
 
@@ -34,6 +35,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	PipelinesClient := datafactory.NewPipelinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PipelinesClient.Client, o.ResourceManagerAuthorizer)
 
+	TriggerRunsClient := datafactory.NewTriggerRunsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&TriggerRunsClient.Client, o.ResourceManagerAuthorizer)
+
 	TriggersClient := datafactory.NewTriggersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -44,6 +48,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		LinkedServiceClient:          &LinkedServiceClient,
 		ManagedVirtualNetworksClient: &ManagedVirtualNetworksClient,
 		PipelinesClient:              &PipelinesClient,
+		TriggerRunsClient:            &TriggerRunsClient,
 		TriggersClient:               &TriggersClient,
 	}
 }
@@ -287,9 +287,28 @@ func expandDataFactoryDatasetLocation(d *pluginsdk.ResourceData) datafactory.Bas
 		return expandDataFactoryDatasetSFTPServerLocation(d)
 	}
 
+	if _, ok := d.GetOk("ftp_server_location"); ok {
+		return expandDataFactoryDatasetFTPServerLocation(d)
+	}
+
 	return nil
 }
 
+func expandDataFactoryDatasetFTPServerLocation(d *pluginsdk.ResourceData) datafactory.BasicDatasetLocation {
+	ftpServerLocations := d.Get("ftp_server_location").([]interface{})
+	if len(ftpServerLocations) == 0 || ftpServerLocations[0] == nil {
+		return nil
+	}
+
+	props := ftpServerLocations[0].(map[string]interface{})
+
+	ftpServerLocation := datafactory.FtpServerLocation{
+		FolderPath: props["path"].(string),
+		FileName:   props["filename"].(string),
+	}
+	return ftpServerLocation
+}
+
 func expandDataFactoryDatasetSFTPServerLocation(d *pluginsdk.ResourceData) datafactory.BasicDatasetLocation {
 	sftpServerLocations := d.Get("sftp_server_location").([]interface{})
 	if len(sftpServerLocations) == 0 || sftpServerLocations[0] == nil {
@@ -443,6 +462,22 @@ func flattenDataFactoryDatasetSFTPLocation(input *datafactory.SftpLocation) []in
 	return []interface{}{result}
 }
 
+func flattenDataFactoryDatasetFTPLocation(input *datafactory.FtpServerLocation) []interface{} {
+	if input == nil {
+		return nil
+	}
+	result := make(map[string]interface{})
+
+	if input.FolderPath != nil {
+		result["path"] = input.FolderPath
+	}
+	if input.FileName != nil {
+		result["filename"] = input.FileName
+	}
+
+	return []interface{}{result}
+}
+
 func flattenDataFactoryDatasetCompression(input datafactory.BasicDatasetCompression) []interface{} {
 	if input == nil {
 		return nil
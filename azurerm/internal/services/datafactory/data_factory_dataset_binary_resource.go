@@ -65,7 +65,7 @@ func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeList,
 				MaxItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"azure_blob_storage_location", "sftp_server_location"},
+				ConflictsWith: []string{"azure_blob_storage_location", "ftp_server_location", "sftp_server_location"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"relative_url": {
@@ -91,7 +91,27 @@ func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeList,
 				MaxItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"azure_blob_storage_location", "http_server_location"},
+				ConflictsWith: []string{"azure_blob_storage_location", "ftp_server_location", "http_server_location"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"filename": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"ftp_server_location": {
+				Type:          pluginsdk.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"azure_blob_storage_location", "http_server_location", "sftp_server_location"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"path": {
@@ -112,7 +132,7 @@ func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeList,
 				MaxItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"http_server_location", "sftp_server_location"},
+				ConflictsWith: []string{"ftp_server_location", "http_server_location", "sftp_server_location"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"container": {
@@ -228,7 +248,7 @@ func resourceDataFactoryDatasetBinaryCreateUpdate(d *pluginsdk.ResourceData, met
 
 	location := expandDataFactoryDatasetLocation(d)
 	if location == nil {
-		return fmt.Errorf("one of `http_server_location`, `azure_blob_storage_location` or `sftp_server_location`, must be specified to create a DataFactory Binary Dataset")
+		return fmt.Errorf("one of `http_server_location`, `azure_blob_storage_location`, `ftp_server_location` or `sftp_server_location`, must be specified to create a DataFactory Binary Dataset")
 	}
 
 	binaryDatasetProperties := datafactory.BinaryDatasetTypeProperties{
@@ -354,6 +374,12 @@ func resourceDataFactoryDatasetBinaryRead(d *pluginsdk.ResourceData, meta interf
 			}
 		}
 
+		if ftpLocation, ok := properties.Location.AsFtpServerLocation(); ok {
+			if err := d.Set("ftp_server_location", flattenDataFactoryDatasetFTPLocation(ftpLocation)); err != nil {
+				return fmt.Errorf("setting `ftp_server_location` for Data Factory Binary Dataset %s", err)
+			}
+		}
+
 		compression := flattenDataFactoryDatasetCompression(properties.Compression)
 		if err := d.Set("compression", compression); err != nil {
 			return fmt.Errorf("setting `compression`: %+v", err)
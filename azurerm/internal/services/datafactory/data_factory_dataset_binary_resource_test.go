@@ -61,6 +61,21 @@ func TestAccDataFactoryDatasetBinary_sftp(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryDatasetBinary_ftp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_dataset_binary", "test")
+	r := DatasetBinaryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ftp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataFactoryDatasetBinary_sftpComplete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_dataset_binary", "test")
 	r := DatasetBinaryResource{}
@@ -235,6 +250,54 @@ resource "azurerm_data_factory_dataset_binary" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (DatasetBinaryResource) ftp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_custom_service" "test" {
+  name                 = "acctestlsftp%d"
+  data_factory_id      = azurerm_data_factory.test.id
+  type                 = "FtpServer"
+  type_properties_json = <<JSON
+{
+  "host": "ftp.example.com",
+  "port": 21,
+  "authenticationType": "Anonymous"
+}
+JSON
+}
+
+resource "azurerm_data_factory_dataset_binary" "test" {
+  name                = "acctestds%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_factory_name   = azurerm_data_factory.test.name
+  linked_service_name = azurerm_data_factory_linked_custom_service.test.name
+
+  ftp_server_location {
+    path     = "/test/"
+    filename = "**"
+  }
+
+  compression {
+    type = "GZip"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (DatasetBinaryResource) sftp_complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,221 @@
+package datafactory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceDataFactoryTriggers() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDataFactoryTriggersRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"data_factory_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DataFactoryName(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			// Data Factory retains the run history for 45 days
+			"run_history_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 45),
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"runtime_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_run_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_run_timestamp": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_run_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataFactoryTriggersRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.TriggersClient
+	runsClient := meta.(*clients.Client).DataFactory.TriggerRunsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataFactoryID(subscriptionId, d.Get("resource_group_name").(string), d.Get("data_factory_name").(string))
+
+	lastRuns, err := dataFactoryLastTriggerRuns(ctx, runsClient, id, d.Get("run_history_in_days").(int))
+	if err != nil {
+		return err
+	}
+
+	iterator, err := client.ListByFactoryComplete(ctx, id.ResourceGroup, id.FactoryName)
+	if err != nil {
+		return fmt.Errorf("listing Triggers for %s: %+v", id, err)
+	}
+
+	triggers := make([]interface{}, 0)
+	for iterator.NotDone() {
+		trigger := iterator.Value()
+
+		name := ""
+		if trigger.Name != nil {
+			name = *trigger.Name
+		}
+
+		triggerType, runtimeState := flattenDataFactoryTriggerTypeAndRuntimeState(trigger.Properties)
+
+		lastRunStatus := ""
+		lastRunTimestamp := ""
+		lastRunMessage := ""
+		if run, ok := lastRuns[name]; ok {
+			lastRunStatus = string(run.Status)
+			if run.TriggerRunTimestamp != nil {
+				lastRunTimestamp = run.TriggerRunTimestamp.Format(time.RFC3339)
+			}
+			if run.Message != nil {
+				lastRunMessage = *run.Message
+			}
+		}
+
+		triggers = append(triggers, map[string]interface{}{
+			"name":               name,
+			"type":               triggerType,
+			"runtime_state":      runtimeState,
+			"last_run_status":    lastRunStatus,
+			"last_run_timestamp": lastRunTimestamp,
+			"last_run_message":   lastRunMessage,
+		})
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Triggers for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("data_factory_name", id.FactoryName)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("triggers", triggers); err != nil {
+		return fmt.Errorf("setting `triggers`: %+v", err)
+	}
+
+	return nil
+}
+
+// dataFactoryLastTriggerRuns returns the most recent run of each Trigger within the Data Factory, keyed by Trigger name
+func dataFactoryLastTriggerRuns(ctx context.Context, client *datafactory.TriggerRunsClient, id parse.DataFactoryId, days int) (map[string]datafactory.TriggerRun, error) {
+	now := time.Now().UTC()
+	filter := datafactory.RunFilterParameters{
+		LastUpdatedAfter:  &date.Time{Time: now.AddDate(0, 0, -days)},
+		LastUpdatedBefore: &date.Time{Time: now},
+		OrderBy: &[]datafactory.RunQueryOrderBy{
+			{
+				OrderBy: datafactory.RunQueryOrderByFieldTriggerRunTimestamp,
+				Order:   datafactory.RunQueryOrderDESC,
+			},
+		},
+	}
+
+	output := make(map[string]datafactory.TriggerRun)
+	for {
+		resp, err := client.QueryByFactory(ctx, id.ResourceGroup, id.FactoryName, filter)
+		if err != nil {
+			return nil, fmt.Errorf("querying Trigger Runs for %s: %+v", id, err)
+		}
+
+		if resp.Value != nil {
+			for _, run := range *resp.Value {
+				if run.TriggerName == nil || run.TriggerRunTimestamp == nil {
+					continue
+				}
+
+				existing, ok := output[*run.TriggerName]
+				if !ok || existing.TriggerRunTimestamp.Before(run.TriggerRunTimestamp.Time) {
+					output[*run.TriggerName] = run
+				}
+			}
+		}
+
+		if resp.ContinuationToken == nil || *resp.ContinuationToken == "" {
+			break
+		}
+		filter.ContinuationToken = utils.String(*resp.ContinuationToken)
+	}
+
+	return output, nil
+}
+
+func flattenDataFactoryTriggerTypeAndRuntimeState(input datafactory.BasicTrigger) (string, string) {
+	switch v := input.(type) {
+	case datafactory.BlobEventsTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.BlobTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.ChainingTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.CustomEventsTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.MultiplePipelineTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.RerunTumblingWindowTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.ScheduleTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.TumblingWindowTrigger:
+		return string(v.Type), string(v.RuntimeState)
+	case datafactory.Trigger:
+		return string(v.Type), string(v.RuntimeState)
+	}
+
+	return "", ""
+}
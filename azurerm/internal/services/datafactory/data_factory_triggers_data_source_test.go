@@ -0,0 +1,40 @@
+package datafactory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type DataFactoryTriggersDataSource struct {
+}
+
+func TestAccDataFactoryTriggersDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_factory_triggers", "test")
+	r := DataFactoryTriggersDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("triggers.#").HasValue("1"),
+				check.That(data.ResourceName).Key("triggers.0.name").Exists(),
+				check.That(data.ResourceName).Key("triggers.0.type").HasValue("ScheduleTrigger"),
+				check.That(data.ResourceName).Key("triggers.0.runtime_state").Exists(),
+			),
+		},
+	})
+}
+
+func (DataFactoryTriggersDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_data_factory_triggers" "test" {
+  data_factory_name   = azurerm_data_factory_trigger_schedule.test.data_factory_name
+  resource_group_name = azurerm_data_factory_trigger_schedule.test.resource_group_name
+}
+`, TriggerScheduleResource{}.basic(data))
+}
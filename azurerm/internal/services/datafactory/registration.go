@@ -21,7 +21,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_data_factory": dataSourceDataFactory(),
+		"azurerm_data_factory":          dataSourceDataFactory(),
+		"azurerm_data_factory_triggers": dataSourceDataFactoryTriggers(),
 	}
 }
 
@@ -52,6 +52,23 @@ func TestAccEventGridSystemTopic_policyStates(t *testing.T) {
 	})
 }
 
+func TestAccEventGridSystemTopic_keyVault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_system_topic", "test")
+	r := EventGridSystemTopicResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("topic_type").HasValue("Microsoft.KeyVault.vaults"),
+				check.That(data.ResourceName).Key("metric_arm_resource_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccEventGridSystemTopic_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_eventgrid_system_topic", "test")
 	r := EventGridSystemTopicResource{}
@@ -133,6 +150,37 @@ resource "azurerm_eventgrid_system_topic" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(12), data.RandomIntOfLength(10))
 }
 
+func (EventGridSystemTopicResource) keyVault(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_eventgrid_system_topic" "test" {
+  name                   = "acctestEGST%d"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  source_arm_resource_id = azurerm_key_vault.test.id
+  topic_type             = "Microsoft.KeyVault.vaults"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomIntOfLength(10))
+}
+
 func (r EventGridSystemTopicResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -96,7 +96,6 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 			"dedicated_cluster_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validate.ClusterID,
 			},
 
@@ -249,6 +248,14 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		// a Namespace can be migrated onto a Dedicated Cluster in-place, however it can't be moved
+		// from one Dedicated Cluster to another, or off of a Dedicated Cluster
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("dedicated_cluster_id", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) != ""
+			}),
+		),
 	}
 }
 
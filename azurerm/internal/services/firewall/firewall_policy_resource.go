@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -166,6 +168,8 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				},
 			},
 
+			"wait_for": sdk.WaitForSchema(),
+
 			"tags": tags.SchemaEnforceLowerCaseKeys(),
 		},
 	}
@@ -227,6 +231,10 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	}
 	d.SetId(*resp.ID)
 
+	if err := sdk.WaitForConfiguredState(ctx, d, firewallPolicyProvisioningStateRefreshFunc(ctx, client, resourceGroup, name)); err != nil {
+		return fmt.Errorf("waiting for Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
 	return resourceFirewallPolicyRead(d, meta)
 }
 
@@ -378,3 +386,18 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		},
 	}
 }
+
+func firewallPolicyProvisioningStateRefreshFunc(ctx context.Context, client *network.FirewallPoliciesClient, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if resp.FirewallPolicyPropertiesFormat == nil {
+			return resp, "", nil
+		}
+
+		return resp, string(resp.FirewallPolicyPropertiesFormat.ProvisioningState), nil
+	}
+}
@@ -31,6 +31,21 @@ func TestAccFirewallPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_waitFor(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.waitFor(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wait_for"),
+	})
+}
+
 func TestAccFirewallPolicy_basicPremium(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
 	r := FirewallPolicyResource{}
@@ -147,6 +162,23 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) waitFor(data acceptance.TestData) string {
+	template := FirewallPolicyResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  wait_for {
+    state = "Succeeded"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) basicPremium(data acceptance.TestData) string {
 	template := FirewallPolicyResource{}.template(data)
 	return fmt.Sprintf(`
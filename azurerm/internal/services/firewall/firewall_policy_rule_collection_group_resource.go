@@ -1,8 +1,12 @@
 package firewall
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -84,9 +88,14 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
+						"rules_json": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
 						"rule": {
 							Type:     pluginsdk.TypeSet,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -183,9 +192,14 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
+						"rules_json": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
 						"rule": {
 							Type:     pluginsdk.TypeSet,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -297,9 +311,14 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								"Dnat",
 							}, false),
 						},
+						"rules_json": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
 						"rule": {
 							Type:     pluginsdk.TypeSet,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -372,9 +391,36 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyRuleCollectionGroupCustomizeDiff),
 	}
 }
 
+// the Rule Collections are Sets, which ExactlyOneOf can't reference the nested `rule` and `rules_json` fields within,
+// so this is validated here instead
+func resourceFirewallPolicyRuleCollectionGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		for _, raw := range d.Get(key).(*pluginsdk.Set).List() {
+			collection, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			hasRules := collection["rule"].(*pluginsdk.Set).Len() > 0
+			hasRulesJson := collection["rules_json"].(string) != ""
+			if hasRules == hasRulesJson {
+				return fmt.Errorf("exactly one of `rule` or `rules_json` must be specified for the Rule Collection %q within `%s`", collection["name"].(string), key)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -408,9 +454,21 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		},
 	}
 	var rulesCollections []network.BasicFirewallPolicyRuleCollection
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())...)
+	applicationRuleCollections, err := expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("expanding `application_rule_collection`: %+v", err)
+	}
+	rulesCollections = append(rulesCollections, applicationRuleCollections...)
+	networkRuleCollections, err := expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("expanding `network_rule_collection`: %+v", err)
+	}
+	rulesCollections = append(rulesCollections, networkRuleCollections...)
+	natRuleCollections, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("expanding `nat_rule_collection`: %+v", err)
+	}
+	rulesCollections = append(rulesCollections, natRuleCollections...)
 	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
 
 	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
@@ -463,7 +521,18 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 	d.Set("priority", resp.Priority)
 	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(subscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
 
-	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections)
+	// collections whose rules are specified using `rules_json` are flattened into `rules_json` rather than `rule`
+	existingRulesJson := make(map[string]string)
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		for _, v := range d.Get(key).(*pluginsdk.Set).List() {
+			collection := v.(map[string]interface{})
+			if rulesJson := collection["rules_json"].(string); rulesJson != "" {
+				existingRulesJson[collection["name"].(string)] = rulesJson
+			}
+		}
+	}
+
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections, existingRulesJson)
 	if err != nil {
 		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 	}
@@ -507,18 +576,22 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
-func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
-	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
+func expandFirewallPolicyRuleCollectionApplication(input []interface{}) ([]network.BasicFirewallPolicyRuleCollection, error) {
+	return expandFirewallPolicyFilterRuleCollection(input, network.RuleTypeApplicationRule, expandFirewallPolicyRuleApplication)
 }
 
-func expandFirewallPolicyRuleCollectionNetwork(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
-	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleNetwork)
+func expandFirewallPolicyRuleCollectionNetwork(input []interface{}) ([]network.BasicFirewallPolicyRuleCollection, error) {
+	return expandFirewallPolicyFilterRuleCollection(input, network.RuleTypeNetworkRule, expandFirewallPolicyRuleNetwork)
 }
 
-func expandFirewallPolicyRuleCollectionNat(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
+func expandFirewallPolicyRuleCollectionNat(input []interface{}) ([]network.BasicFirewallPolicyRuleCollection, error) {
 	result := make([]network.BasicFirewallPolicyRuleCollection, 0)
 	for _, e := range input {
 		rule := e.(map[string]interface{})
+		rules, err := expandFirewallPolicyRuleCollectionRules(rule, network.RuleTypeNatRule, expandFirewallPolicyRuleNat)
+		if err != nil {
+			return nil, err
+		}
 		output := &network.FirewallPolicyNatRuleCollection{
 			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyNatRuleCollection,
 			Name:               utils.String(rule["name"].(string)),
@@ -526,17 +599,21 @@ func expandFirewallPolicyRuleCollectionNat(input []interface{}) []network.BasicF
 			Action: &network.FirewallPolicyNatRuleCollectionAction{
 				Type: network.FirewallPolicyNatRuleCollectionActionType(rule["action"].(string)),
 			},
-			Rules: expandFirewallPolicyRuleNat(rule["rule"].(*pluginsdk.Set).List()),
+			Rules: rules,
 		}
 		result = append(result, output)
 	}
-	return result
+	return result, nil
 }
 
-func expandFirewallPolicyFilterRuleCollection(input []interface{}, f func(input []interface{}) *[]network.BasicFirewallPolicyRule) []network.BasicFirewallPolicyRuleCollection {
+func expandFirewallPolicyFilterRuleCollection(input []interface{}, ruleType network.RuleType, f func(input []interface{}) *[]network.BasicFirewallPolicyRule) ([]network.BasicFirewallPolicyRuleCollection, error) {
 	result := make([]network.BasicFirewallPolicyRuleCollection, 0)
 	for _, e := range input {
 		rule := e.(map[string]interface{})
+		rules, err := expandFirewallPolicyRuleCollectionRules(rule, ruleType, f)
+		if err != nil {
+			return nil, err
+		}
 		output := &network.FirewallPolicyFilterRuleCollection{
 			Action: &network.FirewallPolicyFilterRuleCollectionAction{
 				Type: network.FirewallPolicyFilterRuleCollectionActionType(rule["action"].(string)),
@@ -544,11 +621,85 @@ func expandFirewallPolicyFilterRuleCollection(input []interface{}, f func(input
 			Name:               utils.String(rule["name"].(string)),
 			Priority:           utils.Int32(int32(rule["priority"].(int))),
 			RuleCollectionType: network.RuleCollectionTypeFirewallPolicyFilterRuleCollection,
-			Rules:              f(rule["rule"].(*pluginsdk.Set).List()),
+			Rules:              rules,
 		}
 		result = append(result, output)
 	}
-	return result
+	return result, nil
+}
+
+func expandFirewallPolicyRuleCollectionRules(input map[string]interface{}, ruleType network.RuleType, f func(input []interface{}) *[]network.BasicFirewallPolicyRule) (*[]network.BasicFirewallPolicyRule, error) {
+	name := input["name"].(string)
+	rules := input["rule"].(*pluginsdk.Set).List()
+	rulesJson := input["rules_json"].(string)
+
+	if (len(rules) > 0) == (rulesJson != "") {
+		return nil, fmt.Errorf("exactly one of `rule` or `rules_json` must be specified for the Rule Collection %q", name)
+	}
+
+	if rulesJson == "" {
+		return f(rules), nil
+	}
+
+	result, err := expandFirewallPolicyRulesJson(rulesJson, ruleType)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `rules_json` for the Rule Collection %q: %+v", name, err)
+	}
+	return result, nil
+}
+
+// expandFirewallPolicyRulesJson parses a JSON array of rules in the format used by the API - each rule must be of the
+// type supported by the Rule Collection and only contain properties known to the API
+func expandFirewallPolicyRulesJson(input string, ruleType network.RuleType) (*[]network.BasicFirewallPolicyRule, error) {
+	var rawRules []json.RawMessage
+	if err := json.Unmarshal([]byte(input), &rawRules); err != nil {
+		return nil, fmt.Errorf("expected a JSON array of rules: %+v", err)
+	}
+	if len(rawRules) == 0 {
+		return nil, fmt.Errorf("at least one rule must be specified")
+	}
+
+	result := make([]network.BasicFirewallPolicyRule, 0)
+	for i, rawRule := range rawRules {
+		var discriminator struct {
+			RuleType network.RuleType `json:"ruleType"`
+		}
+		if err := json.Unmarshal(rawRule, &discriminator); err != nil {
+			return nil, fmt.Errorf("rule %d: %+v", i, err)
+		}
+		if discriminator.RuleType != ruleType {
+			return nil, fmt.Errorf("rule %d: expected `ruleType` to be %q but got %q", i, string(ruleType), string(discriminator.RuleType))
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(rawRule))
+		decoder.DisallowUnknownFields()
+
+		var rule network.BasicFirewallPolicyRule
+		var err error
+		switch ruleType {
+		case network.RuleTypeApplicationRule:
+			var v network.ApplicationRule
+			err = decoder.Decode(&v)
+			rule = v
+		case network.RuleTypeNetworkRule:
+			var v network.Rule
+			err = decoder.Decode(&v)
+			rule = v
+		case network.RuleTypeNatRule:
+			var v network.NatRule
+			err = decoder.Decode(&v)
+			rule = v
+		default:
+			return nil, fmt.Errorf("unsupported rule type %q", string(ruleType))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %+v", i, err)
+		}
+
+		result = append(result, rule)
+	}
+
+	return &result, nil
 }
 
 func expandFirewallPolicyRuleApplication(input []interface{}) *[]network.BasicFirewallPolicyRule {
@@ -626,7 +777,7 @@ func expandFirewallPolicyRuleNat(input []interface{}) *[]network.BasicFirewallPo
 	return &result
 }
 
-func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRuleCollection) ([]interface{}, []interface{}, []interface{}, error) {
+func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRuleCollection, existingRulesJson map[string]string) ([]interface{}, []interface{}, []interface{}, error) {
 	var (
 		applicationRuleCollection = []interface{}{}
 		networkRuleCollection     = []interface{}{}
@@ -665,6 +816,25 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 				continue
 			}
 
+			if existing, ok := existingRulesJson[name]; ok {
+				rulesJson, err := flattenFirewallPolicyRulesJson(rule.Rules, existing)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				result["rules_json"] = rulesJson
+				result["rule"] = []interface{}{}
+
+				switch (*rule.Rules)[0].(type) {
+				case network.ApplicationRule:
+					applicationRuleCollection = append(applicationRuleCollection, result)
+				case network.Rule:
+					networkRuleCollection = append(networkRuleCollection, result)
+				default:
+					return nil, nil, nil, fmt.Errorf("unknown rule condition type %+v", (*rule.Rules)[0])
+				}
+				continue
+			}
+
 			// Determine the rule type based on the first rule's type
 			switch (*rule.Rules)[0].(type) {
 			case network.ApplicationRule:
@@ -703,15 +873,25 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 				action = string(rule.Action.Type)
 			}
 
-			rules, err := flattenFirewallPolicyRuleNat(rule.Rules)
-			if err != nil {
-				return nil, nil, nil, err
-			}
 			result = map[string]interface{}{
 				"name":     name,
 				"priority": priority,
 				"action":   action,
-				"rule":     rules,
+			}
+
+			if existing, ok := existingRulesJson[name]; ok {
+				rulesJson, err := flattenFirewallPolicyRulesJson(rule.Rules, existing)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				result["rules_json"] = rulesJson
+				result["rule"] = []interface{}{}
+			} else {
+				rules, err := flattenFirewallPolicyRuleNat(rule.Rules)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				result["rule"] = rules
 			}
 
 			natRuleCollection = append(natRuleCollection, result)
@@ -723,6 +903,69 @@ func flattenFirewallPolicyRuleCollection(input *[]network.BasicFirewallPolicyRul
 	return applicationRuleCollection, networkRuleCollection, natRuleCollection, nil
 }
 
+// flattenFirewallPolicyRulesJson returns the existing `rules_json` when it's equivalent to the rules returned from the API,
+// since the API returns empty properties (and orders keys differently) which would otherwise cause a perpetual diff
+func flattenFirewallPolicyRulesJson(input *[]network.BasicFirewallPolicyRule, existing string) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(*input)
+	if err != nil {
+		return "", fmt.Errorf("marshalling rules: %+v", err)
+	}
+	rulesJson := string(b)
+
+	var actual, expected interface{}
+	if err := json.Unmarshal(b, &actual); err != nil {
+		return "", fmt.Errorf("unmarshalling rules: %+v", err)
+	}
+	if err := json.Unmarshal([]byte(existing), &expected); err != nil {
+		return rulesJson, nil
+	}
+
+	if reflect.DeepEqual(normalizeFirewallPolicyRulesJson(actual), normalizeFirewallPolicyRulesJson(expected)) {
+		return existing, nil
+	}
+
+	return rulesJson, nil
+}
+
+// normalizeFirewallPolicyRulesJson removes empty values (null, empty strings, empty arrays and empty objects) from the input
+func normalizeFirewallPolicyRulesJson(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, value := range v {
+			if normalized := normalizeFirewallPolicyRulesJson(value); normalized != nil {
+				output[key] = normalized
+			}
+		}
+		if len(output) == 0 {
+			return nil
+		}
+		return output
+	case []interface{}:
+		output := make([]interface{}, 0)
+		for _, value := range v {
+			if normalized := normalizeFirewallPolicyRulesJson(value); normalized != nil {
+				output = append(output, normalized)
+			}
+		}
+		if len(output) == 0 {
+			return nil
+		}
+		return output
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
+
 func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRule) ([]interface{}, error) {
 	if input == nil {
 		return []interface{}{}, nil
@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -75,6 +76,34 @@ func TestAccFirewallPolicyRuleCollectionGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_rulesJson(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rulesJson(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// collections are imported as `rule` blocks since there's no way to tell these were specified using `rules_json`
+		data.ImportStepIgnoringBlocks("application_rule_collection", "network_rule_collection", "nat_rule_collection"),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroup_rulesJsonAndRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.rulesJsonAndRule(data),
+			ExpectError: regexp.MustCompile("exactly one of `rule` or `rules_json` must be specified"),
+		},
+	})
+}
+
 func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
 	r := FirewallPolicyRuleCollectionGroupResource{}
@@ -406,6 +435,128 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (FirewallPolicyRuleCollectionGroupResource) rulesJson(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dns {
+    network_rule_fqdn_enabled = false
+  }
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Deny"
+    rules_json = jsonencode([
+      {
+        ruleType        = "ApplicationRule"
+        name            = "app_rule_collection1_rule1"
+        protocols       = [{ protocolType = "Http", port = 80 }, { protocolType = "Https", port = 443 }]
+        sourceAddresses = ["10.0.0.1"]
+        targetFqdns     = [".microsoft.com"]
+      },
+    ])
+  }
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rules_json = jsonencode([
+      for i in range(10) : {
+        ruleType             = "NetworkRule"
+        name                 = "network_rule_collection1_rule${i}"
+        ipProtocols          = ["TCP", "UDP"]
+        sourceAddresses      = ["10.0.0.${i}"]
+        destinationAddresses = ["192.168.1.1"]
+        destinationPorts     = [tostring(1000 + i)]
+      }
+    ])
+  }
+
+  nat_rule_collection {
+    name     = "nat_rule_collection1"
+    priority = 300
+    action   = "Dnat"
+    rule {
+      name                = "nat_rule_collection1_rule1"
+      protocols           = ["TCP"]
+      source_addresses    = ["10.0.0.1", "10.0.0.2"]
+      destination_address = "192.168.1.1"
+      destination_ports   = ["80"]
+      translated_address  = "192.168.0.1"
+      translated_port     = "8080"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (FirewallPolicyRuleCollectionGroupResource) rulesJsonAndRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rules_json = jsonencode([
+      {
+        ruleType             = "NetworkRule"
+        name                 = "network_rule_collection1_rule1"
+        ipProtocols          = ["TCP"]
+        sourceAddresses      = ["10.0.0.1"]
+        destinationAddresses = ["192.168.1.1"]
+        destinationPorts     = ["80"]
+      },
+    ])
+    rule {
+      name                  = "network_rule_collection1_rule2"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.2"]
+      destination_addresses = ["192.168.1.2"]
+      destination_ports     = ["80"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyRuleCollectionGroupResource{}.basic(data)
 	return fmt.Sprintf(`
@@ -93,6 +93,8 @@ https://docs.microsoft.com/en-us/azure/hdinsight/hdinsight-component-versioning#
 
 			"metastores": SchemaHDInsightsExternalMetastores(),
 
+			"network": SchemaHDInsightsNetwork(),
+
 			"storage_account": SchemaHDInsightsStorageAccounts(),
 
 			"roles": {
@@ -143,6 +145,9 @@ func resourceHDInsightStormClusterCreate(d *pluginsdk.ResourceData, meta interfa
 	tier := hdinsight.Tier(d.Get("tier").(string))
 	tls := d.Get("tls_min_version").(string)
 
+	networkPropertiesRaw := d.Get("network").([]interface{})
+	networkProperties := ExpandHDInsightsNetwork(networkPropertiesRaw)
+
 	componentVersionsRaw := d.Get("component_version").([]interface{})
 	componentVersions := expandHDInsightStormComponentVersion(componentVersionsRaw)
 
@@ -190,6 +195,7 @@ func resourceHDInsightStormClusterCreate(d *pluginsdk.ResourceData, meta interfa
 			OsType:                 hdinsight.OSTypeLinux,
 			ClusterVersion:         utils.String(clusterVersion),
 			MinSupportedTLSVersion: utils.String(tls),
+			NetworkProperties:      networkProperties,
 			ClusterDefinition: &hdinsight.ClusterDefinition{
 				Kind:             utils.String("Storm"),
 				ComponentVersion: componentVersions,
@@ -285,6 +291,12 @@ func resourceHDInsightStormClusterRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("tier", string(props.Tier))
 		d.Set("tls_min_version", props.MinSupportedTLSVersion)
 
+		if props.NetworkProperties != nil {
+			if err := d.Set("network", FlattenHDInsightsNetwork(props.NetworkProperties)); err != nil {
+				return fmt.Errorf("flattening `network`: %+v", err)
+			}
+		}
+
 		if def := props.ClusterDefinition; def != nil {
 			if err := d.Set("component_version", flattenHDInsightStormComponentVersion(def.ComponentVersion)); err != nil {
 				return fmt.Errorf("failure flattening `component_version`: %+v", err)
@@ -773,8 +773,10 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"timezone": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								ValidateFunc:     validate.AutoScaleRecurrenceTimeZone(),
+								DiffSuppressFunc: suppress.CaseDifference,
 							},
 							"schedule": {
 								Type:     pluginsdk.TypeList,
@@ -0,0 +1,57 @@
+package iothub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// iothubEndpointAuthenticationCustomizeDiff ensures the credentials for an IoT Hub Endpoint match the `authentication_type`,
+// whilst the schema ensures that exactly one of `connection_string` and `endpoint_uri` is specified
+func iothubEndpointAuthenticationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	hasConnectionString := d.Get("connection_string").(string) != "" || !d.NewValueKnown("connection_string")
+
+	if devices.AuthenticationType(d.Get("authentication_type").(string)) == devices.IdentityBased {
+		if hasConnectionString {
+			return fmt.Errorf("`endpoint_uri` must be specified instead of `connection_string` when `authentication_type` is set to `identityBased`")
+		}
+		return nil
+	}
+
+	if !hasConnectionString {
+		return fmt.Errorf("`connection_string` must be specified instead of `endpoint_uri` when `authentication_type` is set to `keyBased`")
+	}
+
+	return nil
+}
+
+// iothubInlineEndpointsAuthenticationCustomizeDiff ensures the credentials for each `endpoint` block within an IoT Hub
+// match its `authentication_type`
+func iothubInlineEndpointsAuthenticationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for i, raw := range d.Get("endpoint").([]interface{}) {
+		endpoint, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		connectionStringKey := fmt.Sprintf("endpoint.%d.connection_string", i)
+		endpointUriKey := fmt.Sprintf("endpoint.%d.endpoint_uri", i)
+		hasConnectionString := endpoint["connection_string"].(string) != "" || !d.NewValueKnown(connectionStringKey)
+		hasEndpointUri := endpoint["endpoint_uri"].(string) != "" || !d.NewValueKnown(endpointUriKey)
+
+		if devices.AuthenticationType(endpoint["authentication_type"].(string)) == devices.IdentityBased {
+			if hasConnectionString || !hasEndpointUri {
+				return fmt.Errorf("`endpoint_uri` must be specified instead of `connection_string` for the endpoint %q when `authentication_type` is set to `identityBased`", endpoint["name"].(string))
+			}
+			continue
+		}
+
+		if !hasConnectionString || hasEndpointUri {
+			return fmt.Errorf("`connection_string` must be specified instead of `endpoint_uri` for the endpoint %q when `authentication_type` is set to `keyBased`", endpoint["name"].(string))
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package iothub_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -50,6 +51,20 @@ func TestAccIotHubEndpointEventHub_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccIotHubEndpointEventHub_identityBasedWithConnectionString(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_eventhub", "test")
+	r := IotHubEndpointEventHubResource{}
+
+	// the IoT Hub's Managed Identity can't be enabled using the current API version, so only the validation of
+	// identity-based authentication can be tested
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.identityBasedWithConnectionString(data),
+			ExpectError: regexp.MustCompile("`endpoint_uri` must be specified instead of `connection_string`"),
+		},
+	})
+}
+
 func (IotHubEndpointEventHubResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -112,6 +127,23 @@ resource "azurerm_iothub_endpoint_eventhub" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (IotHubEndpointEventHubResource) identityBasedWithConnectionString(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_iothub_endpoint_eventhub" "test" {
+  resource_group_name = "acctestRG-iothub-%[1]d"
+  iothub_name         = "acctestIoTHub-%[1]d"
+  name                = "acctest"
+  connection_string   = "Endpoint=sb://acctest.servicebus.windows.net/;SharedAccessKeyName=acctest;SharedAccessKey=bm90LWEtcmVhbC1rZXk=;EntityPath=acctest"
+
+  authentication_type = "identityBased"
+}
+`, data.RandomInteger)
+}
+
 func (r IotHubEndpointEventHubResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
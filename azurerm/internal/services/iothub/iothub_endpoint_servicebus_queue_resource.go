@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -50,9 +51,35 @@ func resourceIotHubEndpointServiceBusQueue() *pluginsdk.Resource {
 				ValidateFunc: validate.IoTHubName,
 			},
 
-			"connection_string": {
+			"authentication_type": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
+				Default:  string(devices.KeyBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devices.KeyBased),
+					string(devices.IdentityBased),
+				}, false),
+			},
+
+			"endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"connection_string", "endpoint_uri"},
+				RequiredWith: []string{"entity_path"},
+			},
+
+			"entity_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"endpoint_uri"},
+			},
+
+			"connection_string": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"connection_string", "endpoint_uri"},
 				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 					sharedAccessKeyRegex := regexp.MustCompile("SharedAccessKey=[^;]+")
 					sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
@@ -64,6 +91,8 @@ func resourceIotHubEndpointServiceBusQueue() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(iothubEndpointAuthenticationCustomizeDiff),
 	}
 }
 
@@ -92,10 +121,17 @@ func resourceIotHubEndpointServiceBusQueueCreateUpdate(d *pluginsdk.ResourceData
 	resourceId := fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
 
 	queueEndpoint := devices.RoutingServiceBusQueueEndpointProperties{
-		ConnectionString: utils.String(d.Get("connection_string").(string)),
-		Name:             utils.String(endpointName),
-		SubscriptionID:   utils.String(subscriptionID),
-		ResourceGroup:    utils.String(resourceGroup),
+		AuthenticationType: devices.AuthenticationType(d.Get("authentication_type").(string)),
+		Name:               utils.String(endpointName),
+		SubscriptionID:     utils.String(subscriptionID),
+		ResourceGroup:      utils.String(resourceGroup),
+	}
+
+	if queueEndpoint.AuthenticationType == devices.IdentityBased {
+		queueEndpoint.EndpointURI = utils.String(d.Get("endpoint_uri").(string))
+		queueEndpoint.EntityPath = utils.String(d.Get("entity_path").(string))
+	} else {
+		queueEndpoint.ConnectionString = utils.String(d.Get("connection_string").(string))
 	}
 
 	routing := iothub.Properties.Routing
@@ -180,7 +216,26 @@ func resourceIotHubEndpointServiceBusQueueRead(d *pluginsdk.ResourceData, meta i
 		for _, endpoint := range *endpoints {
 			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
 				if strings.EqualFold(*existingEndpointName, endpointName) {
-					d.Set("connection_string", endpoint.ConnectionString)
+					authenticationType := string(devices.KeyBased)
+					if endpoint.AuthenticationType != "" {
+						authenticationType = string(endpoint.AuthenticationType)
+					}
+					d.Set("authentication_type", authenticationType)
+
+					endpointUri := ""
+					entityPath := ""
+					if endpoint.AuthenticationType == devices.IdentityBased {
+						if endpoint.EndpointURI != nil {
+							endpointUri = *endpoint.EndpointURI
+						}
+						if endpoint.EntityPath != nil {
+							entityPath = *endpoint.EntityPath
+						}
+					} else {
+						d.Set("connection_string", endpoint.ConnectionString)
+					}
+					d.Set("endpoint_uri", endpointUri)
+					d.Set("entity_path", entityPath)
 				}
 			}
 		}
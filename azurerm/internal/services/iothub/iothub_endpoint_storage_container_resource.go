@@ -78,9 +78,27 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(10485760, 524288000),
 			},
 
-			"connection_string": {
+			"authentication_type": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
+				Default:  string(devices.KeyBased),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devices.KeyBased),
+					string(devices.IdentityBased),
+				}, false),
+			},
+
+			"endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"connection_string", "endpoint_uri"},
+			},
+
+			"connection_string": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"connection_string", "endpoint_uri"},
 				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 					accountKeyRegex := regexp.MustCompile("AccountKey=[^;]+")
 
@@ -100,6 +118,8 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 				}, true),
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(iothubEndpointAuthenticationCustomizeDiff),
 	}
 }
 
@@ -127,7 +147,6 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 	endpointName := d.Get("name").(string)
 	resourceId := fmt.Sprintf("%s/Endpoints/%s", *iothub.ID, endpointName)
 
-	connectionStr := d.Get("connection_string").(string)
 	containerName := d.Get("container_name").(string)
 	fileNameFormat := d.Get("file_name_format").(string)
 	batchFrequencyInSeconds := int32(d.Get("batch_frequency_in_seconds").(int))
@@ -135,7 +154,7 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 	encoding := d.Get("encoding").(string)
 
 	storageContainerEndpoint := devices.RoutingStorageContainerProperties{
-		ConnectionString:        &connectionStr,
+		AuthenticationType:      devices.AuthenticationType(d.Get("authentication_type").(string)),
 		Name:                    &endpointName,
 		SubscriptionID:          &subscriptionID,
 		ResourceGroup:           &resourceGroup,
@@ -146,6 +165,12 @@ func resourceIotHubEndpointStorageContainerCreateUpdate(d *pluginsdk.ResourceDat
 		Encoding:                devices.Encoding(encoding),
 	}
 
+	if storageContainerEndpoint.AuthenticationType == devices.IdentityBased {
+		storageContainerEndpoint.EndpointURI = utils.String(d.Get("endpoint_uri").(string))
+	} else {
+		storageContainerEndpoint.ConnectionString = utils.String(d.Get("connection_string").(string))
+	}
+
 	routing := iothub.Properties.Routing
 
 	if routing == nil {
@@ -230,7 +255,21 @@ func resourceIotHubEndpointStorageContainerRead(d *pluginsdk.ResourceData, meta
 		for _, endpoint := range *endpoints {
 			if existingEndpointName := endpoint.Name; existingEndpointName != nil {
 				if strings.EqualFold(*existingEndpointName, endpointName) {
-					d.Set("connection_string", endpoint.ConnectionString)
+					authenticationType := string(devices.KeyBased)
+					if endpoint.AuthenticationType != "" {
+						authenticationType = string(endpoint.AuthenticationType)
+					}
+					d.Set("authentication_type", authenticationType)
+
+					endpointUri := ""
+					if endpoint.AuthenticationType == devices.IdentityBased {
+						if endpoint.EndpointURI != nil {
+							endpointUri = *endpoint.EndpointURI
+						}
+					} else {
+						d.Set("connection_string", endpoint.ConnectionString)
+					}
+					d.Set("endpoint_uri", endpointUri)
 					d.Set("container_name", endpoint.ContainerName)
 					d.Set("file_name_format", endpoint.FileNameFormat)
 					d.Set("batch_frequency_in_seconds", endpoint.BatchFrequencyInSeconds)
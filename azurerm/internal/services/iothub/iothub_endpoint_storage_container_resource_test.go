@@ -3,6 +3,7 @@ package iothub_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -54,6 +55,20 @@ func TestAccIotHubEndpointStorageContainer_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccIotHubEndpointStorageContainer_identityBasedWithConnectionString(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_endpoint_storage_container", "test")
+	r := IotHubEndpointStorageContainerResource{}
+
+	// the IoT Hub's Managed Identity can't be enabled using the current API version, so only the validation of
+	// identity-based authentication can be tested
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.identityBasedWithConnectionString(data),
+			ExpectError: regexp.MustCompile("`endpoint_uri` must be specified instead of `connection_string`"),
+		},
+	})
+}
+
 func (IotHubEndpointStorageContainerResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -110,6 +125,24 @@ resource "azurerm_iothub_endpoint_storage_container" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (IotHubEndpointStorageContainerResource) identityBasedWithConnectionString(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_iothub_endpoint_storage_container" "test" {
+  resource_group_name = "acctestRG-iothub-%[1]d"
+  iothub_name         = "acctestIoTHub-%[1]d"
+  name                = "acctest"
+  container_name      = "acctestcont"
+  connection_string   = "DefaultEndpointsProtocol=https;AccountName=acctest;AccountKey=bm90LWEtcmVhbC1rZXk=;EndpointSuffix=core.windows.net"
+
+  authentication_type = "identityBased"
+}
+`, data.RandomInteger)
+}
+
 func (r IotHubEndpointStorageContainerResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
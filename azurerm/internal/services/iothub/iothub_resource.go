@@ -63,6 +63,8 @@ func resourceIotHub() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(iothubInlineEndpointsAuthenticationCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -228,9 +230,31 @@ func resourceIotHub() *pluginsdk.Resource {
 							}, false),
 						},
 
+						"authentication_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(devices.KeyBased),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devices.KeyBased),
+								string(devices.IdentityBased),
+							}, false),
+						},
+
+						"endpoint_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"entity_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
 						"connection_string": {
 							Type:     pluginsdk.TypeString,
-							Required: true,
+							Optional: true,
 							DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 								secretKeyRegex := regexp.MustCompile("(SharedAccessKey|AccountKey)=[^;]+")
 								sbProtocolRegex := regexp.MustCompile("sb://([^:]+)(:5671)?/;")
@@ -917,11 +941,20 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 		endpoint := endpointRaw.(map[string]interface{})
 
 		t := endpoint["type"]
-		connectionStr := endpoint["connection_string"].(string)
+		authenticationType := devices.AuthenticationType(endpoint["authentication_type"].(string))
 		name := endpoint["name"].(string)
 		resourceGroup := endpoint["resource_group_name"].(string)
 		subscriptionID := subscriptionId
 
+		// identity based endpoints are addressed by their URI (and entity path) rather than a connection string
+		var connectionStr, endpointUri, entityPath *string
+		if authenticationType == devices.IdentityBased {
+			endpointUri = utils.String(endpoint["endpoint_uri"].(string))
+			entityPath = utils.String(endpoint["entity_path"].(string))
+		} else {
+			connectionStr = utils.String(endpoint["connection_string"].(string))
+		}
+
 		switch t {
 		case "AzureIotHub.StorageContainer":
 			containerName := endpoint["container_name"].(string)
@@ -931,7 +964,9 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 			encoding := endpoint["encoding"].(string)
 
 			storageContainer := devices.RoutingStorageContainerProperties{
-				ConnectionString:        &connectionStr,
+				AuthenticationType:      authenticationType,
+				ConnectionString:        connectionStr,
+				EndpointURI:             endpointUri,
 				Name:                    &name,
 				SubscriptionID:          &subscriptionID,
 				ResourceGroup:           &resourceGroup,
@@ -945,28 +980,37 @@ func expandIoTHubEndpoints(d *pluginsdk.ResourceData, subscriptionId string) *de
 
 		case "AzureIotHub.ServiceBusQueue":
 			sbQueue := devices.RoutingServiceBusQueueEndpointProperties{
-				ConnectionString: &connectionStr,
-				Name:             &name,
-				SubscriptionID:   &subscriptionID,
-				ResourceGroup:    &resourceGroup,
+				AuthenticationType: authenticationType,
+				ConnectionString:   connectionStr,
+				EndpointURI:        endpointUri,
+				EntityPath:         entityPath,
+				Name:               &name,
+				SubscriptionID:     &subscriptionID,
+				ResourceGroup:      &resourceGroup,
 			}
 			serviceBusQueueEndpointProperties = append(serviceBusQueueEndpointProperties, sbQueue)
 
 		case "AzureIotHub.ServiceBusTopic":
 			sbTopic := devices.RoutingServiceBusTopicEndpointProperties{
-				ConnectionString: &connectionStr,
-				Name:             &name,
-				SubscriptionID:   &subscriptionID,
-				ResourceGroup:    &resourceGroup,
+				AuthenticationType: authenticationType,
+				ConnectionString:   connectionStr,
+				EndpointURI:        endpointUri,
+				EntityPath:         entityPath,
+				Name:               &name,
+				SubscriptionID:     &subscriptionID,
+				ResourceGroup:      &resourceGroup,
 			}
 			serviceBusTopicEndpointProperties = append(serviceBusTopicEndpointProperties, sbTopic)
 
 		case "AzureIotHub.EventHub":
 			eventHub := devices.RoutingEventHubProperties{
-				ConnectionString: &connectionStr,
-				Name:             &name,
-				SubscriptionID:   &subscriptionID,
-				ResourceGroup:    &resourceGroup,
+				AuthenticationType: authenticationType,
+				ConnectionString:   connectionStr,
+				EndpointURI:        endpointUri,
+				EntityPath:         entityPath,
+				Name:               &name,
+				SubscriptionID:     &subscriptionID,
+				ResourceGroup:      &resourceGroup,
 			}
 			eventHubProperties = append(eventHubProperties, eventHub)
 		}
@@ -1096,6 +1140,14 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if connString := container.ConnectionString; connString != nil {
 					output["connection_string"] = *connString
 				}
+				authenticationType := string(devices.KeyBased)
+				if container.AuthenticationType != "" {
+					authenticationType = string(container.AuthenticationType)
+				}
+				output["authentication_type"] = authenticationType
+				if endpointUri := container.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
 				if name := container.Name; name != nil {
 					output["name"] = *name
 				}
@@ -1129,6 +1181,17 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if connString := queue.ConnectionString; connString != nil {
 					output["connection_string"] = *connString
 				}
+				authenticationType := string(devices.KeyBased)
+				if queue.AuthenticationType != "" {
+					authenticationType = string(queue.AuthenticationType)
+				}
+				output["authentication_type"] = authenticationType
+				if endpointUri := queue.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				if entityPath := queue.EntityPath; entityPath != nil {
+					output["entity_path"] = *entityPath
+				}
 				if name := queue.Name; name != nil {
 					output["name"] = *name
 				}
@@ -1149,6 +1212,17 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if connString := topic.ConnectionString; connString != nil {
 					output["connection_string"] = *connString
 				}
+				authenticationType := string(devices.KeyBased)
+				if topic.AuthenticationType != "" {
+					authenticationType = string(topic.AuthenticationType)
+				}
+				output["authentication_type"] = authenticationType
+				if endpointUri := topic.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				if entityPath := topic.EntityPath; entityPath != nil {
+					output["entity_path"] = *entityPath
+				}
 				if name := topic.Name; name != nil {
 					output["name"] = *name
 				}
@@ -1169,6 +1243,17 @@ func flattenIoTHubEndpoint(input *devices.RoutingProperties) []interface{} {
 				if connString := eventHub.ConnectionString; connString != nil {
 					output["connection_string"] = *connString
 				}
+				authenticationType := string(devices.KeyBased)
+				if eventHub.AuthenticationType != "" {
+					authenticationType = string(eventHub.AuthenticationType)
+				}
+				output["authentication_type"] = authenticationType
+				if endpointUri := eventHub.EndpointURI; endpointUri != nil {
+					output["endpoint_uri"] = *endpointUri
+				}
+				if entityPath := eventHub.EntityPath; entityPath != nil {
+					output["entity_path"] = *entityPath
+				}
 				if name := eventHub.Name; name != nil {
 					output["name"] = *name
 				}
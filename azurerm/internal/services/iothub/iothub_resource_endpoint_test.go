@@ -0,0 +1,93 @@
+package iothub
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/iothub/mgmt/2020-03-01/devices"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// an identity based Endpoint created by one of the standalone Endpoint resources must survive an update
+// to the IoT Hub, which re-sends the Endpoints it read back from the API
+func TestIoTHubEndpointsRoundTripPreservesIdentityBasedEndpoints(t *testing.T) {
+	existing := &devices.RoutingProperties{
+		Endpoints: &devices.RoutingEndpoints{
+			EventHubs: &[]devices.RoutingEventHubProperties{
+				{
+					AuthenticationType: devices.IdentityBased,
+					EndpointURI:        utils.String("sb://example.servicebus.windows.net"),
+					EntityPath:         utils.String("example"),
+					Name:               utils.String("identity"),
+					ResourceGroup:      utils.String("example-resources"),
+				},
+			},
+			StorageContainers: &[]devices.RoutingStorageContainerProperties{
+				{
+					AuthenticationType: devices.IdentityBased,
+					EndpointURI:        utils.String("https://example.blob.core.windows.net"),
+					Name:               utils.String("storage"),
+					ResourceGroup:      utils.String("example-resources"),
+					ContainerName:      utils.String("example"),
+					Encoding:           devices.Avro,
+				},
+			},
+			ServiceBusQueues: &[]devices.RoutingServiceBusQueueEndpointProperties{
+				{
+					ConnectionString: utils.String("Endpoint=sb://example.servicebus.windows.net:5671/;SharedAccessKeyName=example;SharedAccessKey=****;EntityPath=example"),
+					Name:             utils.String("key"),
+					ResourceGroup:    utils.String("example-resources"),
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceIotHub().Schema, map[string]interface{}{})
+	if err := d.Set("endpoint", flattenIoTHubEndpoint(existing)); err != nil {
+		t.Fatalf("setting `endpoint`: %+v", err)
+	}
+
+	actual := expandIoTHubEndpoints(d, "00000000-0000-0000-0000-000000000000")
+
+	if len(*actual.EventHubs) != 1 {
+		t.Fatalf("expected 1 Event Hub Endpoint but got %d", len(*actual.EventHubs))
+	}
+	eventHub := (*actual.EventHubs)[0]
+	if eventHub.AuthenticationType != devices.IdentityBased {
+		t.Fatalf("expected the Event Hub Endpoint to be %q but got %q", devices.IdentityBased, eventHub.AuthenticationType)
+	}
+	if eventHub.ConnectionString != nil {
+		t.Fatalf("expected no connection string for the Event Hub Endpoint but got %q", *eventHub.ConnectionString)
+	}
+	if eventHub.EndpointURI == nil || *eventHub.EndpointURI != "sb://example.servicebus.windows.net" {
+		t.Fatalf("expected the Event Hub Endpoint URI to be preserved but got %v", eventHub.EndpointURI)
+	}
+	if eventHub.EntityPath == nil || *eventHub.EntityPath != "example" {
+		t.Fatalf("expected the Event Hub Entity Path to be preserved but got %v", eventHub.EntityPath)
+	}
+
+	if len(*actual.StorageContainers) != 1 {
+		t.Fatalf("expected 1 Storage Container Endpoint but got %d", len(*actual.StorageContainers))
+	}
+	container := (*actual.StorageContainers)[0]
+	if container.AuthenticationType != devices.IdentityBased {
+		t.Fatalf("expected the Storage Container Endpoint to be %q but got %q", devices.IdentityBased, container.AuthenticationType)
+	}
+	if container.ConnectionString != nil {
+		t.Fatalf("expected no connection string for the Storage Container Endpoint but got %q", *container.ConnectionString)
+	}
+	if container.EndpointURI == nil || *container.EndpointURI != "https://example.blob.core.windows.net" {
+		t.Fatalf("expected the Storage Container Endpoint URI to be preserved but got %v", container.EndpointURI)
+	}
+
+	if len(*actual.ServiceBusQueues) != 1 {
+		t.Fatalf("expected 1 Service Bus Queue Endpoint but got %d", len(*actual.ServiceBusQueues))
+	}
+	queue := (*actual.ServiceBusQueues)[0]
+	if queue.AuthenticationType != devices.KeyBased {
+		t.Fatalf("expected the Service Bus Queue Endpoint to be %q but got %q", devices.KeyBased, queue.AuthenticationType)
+	}
+	if queue.ConnectionString == nil || queue.EndpointURI != nil {
+		t.Fatalf("expected only a connection string for the Service Bus Queue Endpoint")
+	}
+}
@@ -60,6 +60,16 @@ func dataSourceKeyVaultCertificate() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Computed: true,
 									},
+
+									"certificate_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"certificate_transparency_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Computed: true,
+									},
 								},
 							},
 						},
@@ -320,7 +330,9 @@ func flattenKeyVaultCertificatePolicyForDataSource(input *keyvault.CertificatePo
 		}
 		policy["issuer_parameters"] = []interface{}{
 			map[string]interface{}{
-				"name": name,
+				"name":                             name,
+				"certificate_type":                 utils.NormalizeNilableString(params.CertificateType),
+				"certificate_transparency_enabled": params.CertificateTransparency != nil && *params.CertificateTransparency,
 			},
 		}
 	}
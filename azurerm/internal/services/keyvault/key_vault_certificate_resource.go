@@ -99,6 +99,20 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 										Required: true,
 										ForceNew: true,
 									},
+
+									"certificate_type": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"certificate_transparency_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+										ForceNew: true,
+									},
 								},
 							},
 						},
@@ -700,6 +714,10 @@ func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.Certi
 	policy.IssuerParameters = &keyvault.IssuerParameters{
 		Name: utils.String(issuer["name"].(string)),
 	}
+	if v := issuer["certificate_type"].(string); v != "" {
+		policy.IssuerParameters.CertificateType = utils.String(v)
+	}
+	policy.IssuerParameters.CertificateTransparency = utils.Bool(issuer["certificate_transparency_enabled"].(bool))
 
 	properties := policyRaw["key_properties"].([]interface{})
 	props := properties[0].(map[string]interface{})
@@ -838,6 +856,8 @@ func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy, certDat
 	if params := input.IssuerParameters; params != nil {
 		issuerParams := make(map[string]interface{})
 		issuerParams["name"] = *params.Name
+		issuerParams["certificate_type"] = utils.NormalizeNilableString(params.CertificateType)
+		issuerParams["certificate_transparency_enabled"] = params.CertificateTransparency != nil && *params.CertificateTransparency
 		policy["issuer_parameters"] = []interface{}{issuerParams}
 	}
 
@@ -0,0 +1,420 @@
+package keyvault
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	keyVaultSecretRandomLowerCharacters   = "abcdefghijklmnopqrstuvwxyz"
+	keyVaultSecretRandomUpperCharacters   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	keyVaultSecretRandomNumericCharacters = "0123456789"
+	keyVaultSecretRandomSpecialCharacters = "!@#$%&*()-_=+[]{}<>:?"
+)
+
+func resourceKeyVaultSecretRandom() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultSecretRandomCreate,
+		Read:   resourceKeyVaultSecretRandomRead,
+		Update: resourceKeyVaultSecretRandomUpdate,
+		Delete: resourceKeyVaultSecretRandomDelete,
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.ParseNestedItemID(id)
+			return err
+		}, nestedItemResourceImporter),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"key_vault_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.VaultID,
+			},
+
+			"length": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1024),
+			},
+
+			"lower": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"upper": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"numeric": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"special": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"rotation_trigger": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"content_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"not_before_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceKeyVaultSecretRandomCustomizeDiff),
+	}
+}
+
+func resourceKeyVaultSecretRandomCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"lower", "upper", "numeric", "special"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if keyVaultSecretRandomCharacters(d) == "" {
+		return fmt.Errorf("at least one of `lower`, `upper`, `numeric` or `special` must be enabled")
+	}
+
+	return nil
+}
+
+func resourceKeyVaultSecretRandomCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultBaseUrl, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, *keyVaultId, err)
+	}
+
+	existing, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Secret %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_secret_random", *existing.ID)
+	}
+
+	value, err := generateKeyVaultSecretRandomValue(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := keyvault.SecretSetParameters{
+		Value:            utils.String(value),
+		ContentType:      utils.String(d.Get("content_type").(string)),
+		Tags:             tags.Expand(d.Get("tags").(map[string]interface{})),
+		SecretAttributes: expandKeyVaultSecretRandomAttributes(d),
+	}
+
+	if _, err := client.SetSecret(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+		return fmt.Errorf("setting Secret %q (Key Vault %q): %+v", name, *keyVaultBaseUrl, err)
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+	if err != nil {
+		return err
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Secret '%s' (in key vault '%s')", name, *keyVaultBaseUrl)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceKeyVaultSecretRandomRead(d, meta)
+}
+
+func resourceKeyVaultSecretRandomUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	contentType := d.Get("content_type").(string)
+	t := d.Get("tags").(map[string]interface{})
+
+	// the generation policy isn't available from the API, so when the Secret has been imported (and the
+	// policy is being populated from the configuration for the first time) the existing value is retained
+	oldLength, _ := d.GetChange("length")
+	imported := oldLength.(int) == 0
+
+	if !imported && d.HasChanges("length", "lower", "upper", "numeric", "special", "rotation_trigger") {
+		// a new value is generated (as a new version of the secret) whenever the policy or the triggers change
+		value, err := generateKeyVaultSecretRandomValue(d)
+		if err != nil {
+			return err
+		}
+
+		parameters := keyvault.SecretSetParameters{
+			Value:            utils.String(value),
+			ContentType:      utils.String(contentType),
+			Tags:             tags.Expand(t),
+			SecretAttributes: expandKeyVaultSecretRandomAttributes(d),
+		}
+
+		if _, err = client.SetSecret(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+			return fmt.Errorf("rotating Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	} else {
+		parameters := keyvault.SecretUpdateParameters{
+			ContentType:      utils.String(contentType),
+			Tags:             tags.Expand(t),
+			SecretAttributes: expandKeyVaultSecretRandomAttributes(d),
+		}
+
+		if _, err = client.UpdateSecret(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+			return fmt.Errorf("updating Secret %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	// "" indicates the latest version
+	read, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("getting Key Vault Secret %q : %+v", id.Name, err)
+	}
+
+	if _, err = parse.ParseNestedItemID(*read.ID); err != nil {
+		return err
+	}
+
+	// the ID is suffixed with the secret version
+	d.SetId(*read.ID)
+
+	return resourceKeyVaultSecretRandomRead(d, meta)
+}
+
+func resourceKeyVaultSecretRandomRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return fmt.Errorf("checking if key vault %q for Secret %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if !ok {
+		log.Printf("[DEBUG] Secret %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	// we always want to get the latest version
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Secret %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("making Read request on Azure KeyVault Secret %s: %+v", id.Name, err)
+	}
+
+	// the version may have changed, so parse the updated id
+	respID, err := parse.ParseNestedItemID(*resp.ID)
+	if err != nil {
+		return err
+	}
+
+	// the generated value is intentionally never set into the state
+	d.Set("name", respID.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("version", respID.Version)
+	d.Set("content_type", resp.ContentType)
+	d.Set("versionless_id", id.VersionlessID())
+
+	if attributes := resp.Attributes; attributes != nil {
+		if v := attributes.NotBefore; v != nil {
+			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
+		}
+
+		if v := attributes.Expires; v != nil {
+			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultSecretRandomDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy
+	description := fmt.Sprintf("Secret %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
+	deleter := deleteAndPurgeSecret{
+		client:      client,
+		keyVaultUri: id.KeyVaultBaseUrl,
+		name:        id.Name,
+	}
+	return deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter)
+}
+
+func expandKeyVaultSecretRandomAttributes(d *pluginsdk.ResourceData) *keyvault.SecretAttributes {
+	attributes := &keyvault.SecretAttributes{}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		attributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	return attributes
+}
+
+// keyVaultSecretRandomCharacters returns the characters which the random value can be generated from
+func keyVaultSecretRandomCharacters(d interface{ Get(string) interface{} }) string {
+	characters := ""
+	if d.Get("lower").(bool) {
+		characters += keyVaultSecretRandomLowerCharacters
+	}
+	if d.Get("upper").(bool) {
+		characters += keyVaultSecretRandomUpperCharacters
+	}
+	if d.Get("numeric").(bool) {
+		characters += keyVaultSecretRandomNumericCharacters
+	}
+	if d.Get("special").(bool) {
+		characters += keyVaultSecretRandomSpecialCharacters
+	}
+	return characters
+}
+
+func generateKeyVaultSecretRandomValue(d *pluginsdk.ResourceData) (string, error) {
+	// this is validated in the CustomizeDiff, but is checked here too since an empty character set can't be generated from
+	characters := keyVaultSecretRandomCharacters(d)
+	if characters == "" {
+		return "", fmt.Errorf("at least one of `lower`, `upper`, `numeric` or `special` must be enabled")
+	}
+
+	length := d.Get("length").(int)
+	max := big.NewInt(int64(len(characters)))
+	value := make([]byte, length)
+	for i := range value {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("generating random value: %+v", err)
+		}
+		value[i] = characters[n.Int64()]
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,199 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultSecretRandomResource struct{}
+
+func TestAccKeyVaultSecretRandom_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_random", "test")
+	r := KeyVaultSecretRandomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").DoesNotExist(),
+			),
+		},
+		data.ImportStep("length", "lower", "upper", "numeric", "special"),
+	})
+}
+
+func TestAccKeyVaultSecretRandom_noCharacters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_random", "test")
+	r := KeyVaultSecretRandomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.noCharacters(data),
+			ExpectError: regexp.MustCompile("at least one of `lower`, `upper`, `numeric` or `special` must be enabled"),
+		},
+	})
+}
+
+func TestAccKeyVaultSecretRandom_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_random", "test")
+	r := KeyVaultSecretRandomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKeyVaultSecretRandom_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_random", "test")
+	r := KeyVaultSecretRandomResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("length", "lower", "upper", "numeric", "special", "rotation_trigger"),
+	})
+}
+
+func TestAccKeyVaultSecretRandom_rotate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret_random", "test")
+	r := KeyVaultSecretRandomResource{}
+	version := ""
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(func(_ context.Context, _ *clients.Client, state *pluginsdk.InstanceState) error {
+					version = state.Attributes["version"]
+					return nil
+				}),
+			),
+		},
+		{
+			Config: r.complete(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(func(_ context.Context, _ *clients.Client, state *pluginsdk.InstanceState) error {
+					if state.Attributes["version"] == version {
+						return fmt.Errorf("expected a new version of the secret to be created but it's still %q", version)
+					}
+					return nil
+				}),
+			),
+		},
+		data.ImportStep("length", "lower", "upper", "numeric", "special", "rotation_trigger"),
+	})
+}
+
+func (KeyVaultSecretRandomResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagementClient.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Key Vault Secret %q: %+v", id.Name, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r KeyVaultSecretRandomResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secret_random" "test" {
+  name         = "secret-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  length       = 32
+}
+`, KeyVaultSecretResource{}.template(data), data.RandomString)
+}
+
+func (r KeyVaultSecretRandomResource) noCharacters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secret_random" "test" {
+  name         = "secret-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  length       = 32
+  lower        = false
+  upper        = false
+  numeric      = false
+  special      = false
+}
+`, KeyVaultSecretResource{}.template(data), data.RandomString)
+}
+
+func (r KeyVaultSecretRandomResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret_random" "import" {
+  name         = azurerm_key_vault_secret_random.test.name
+  key_vault_id = azurerm_key_vault_secret_random.test.key_vault_id
+  length       = azurerm_key_vault_secret_random.test.length
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultSecretRandomResource) complete(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_secret_random" "test" {
+  name            = "secret-%s"
+  key_vault_id    = azurerm_key_vault.test.id
+  length          = 24
+  special         = false
+  content_type    = "password"
+  expiration_date = "2035-12-31T00:00:00Z"
+
+  rotation_trigger = {
+    rotation = "%s"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, KeyVaultSecretResource{}.template(data), data.RandomString, trigger)
+}
@@ -42,6 +42,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_key_vault_key":                              resourceKeyVaultKey(),
 		"azurerm_key_vault_managed_hardware_security_module": resourceKeyVaultManagedHardwareSecurityModule(),
 		"azurerm_key_vault_secret":                           resourceKeyVaultSecret(),
+		"azurerm_key_vault_secret_random":                    resourceKeyVaultSecretRandom(),
 		"azurerm_key_vault":                                  resourceKeyVault(),
 	}
 }
@@ -1,6 +1,7 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -210,6 +212,8 @@ func resourceKustoCluster() *pluginsdk.Resource {
 
 			"zones": azure.SchemaZones(),
 
+			"wait_for": sdk.WaitForSchema(),
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -258,14 +262,17 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 			sku.Capacity = utils.Int32(*optimizedAutoScale.Maximum)
 		}
 
-		// Capacity must be set for the initial creation when using OptimizedAutoScaling but cannot be updated
-		if d.HasChange("sku.0.capacity") && !d.IsNewResource() {
-			return fmt.Errorf("cannot change `sku.capacity` when `optimized_auto_scaling.enabled` is set to `true`")
-		}
-
 		if *optimizedAutoScale.Minimum > *optimizedAutoScale.Maximum {
 			return fmt.Errorf("`optimized_auto_scaling.maximum_instances` must be >= `optimized_auto_scaling.minimum_instances`")
 		}
+
+		// the API rejects changes to the SKU whilst Optimized AutoScale is enabled, so (as the Portal does) we
+		// pause Optimized AutoScale, apply the new SKU and then re-enable it as a part of the update below
+		if !d.IsNewResource() && d.HasChange("sku") {
+			if err := kustoClusterUpdateSkuWithOptimizedAutoScalePaused(ctx, client, resourceGroup, name, *sku, d.Get("optimized_auto_scale").([]interface{})); err != nil {
+				return err
+			}
+		}
 	}
 
 	engine := kusto.EngineType(d.Get("engine").(string))
@@ -369,6 +376,10 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	if err := sdk.WaitForConfiguredState(ctx, d, kustoClusterStateRefreshFunc(ctx, client, resourceGroup, name)); err != nil {
+		return fmt.Errorf("waiting for Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
 	return resourceKustoClusterRead(d, meta)
 }
 
@@ -455,6 +466,77 @@ func resourceKustoClusterDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+func kustoClusterUpdateSkuWithOptimizedAutoScalePaused(ctx context.Context, client *kusto.ClustersClient, resourceGroup, name string, sku kusto.AzureSku, optimizedAutoScaleRaw []interface{}) (err error) {
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("retrieving Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if props := existing.ClusterProperties; props != nil && props.OptimizedAutoscale != nil && props.OptimizedAutoscale.IsEnabled != nil && *props.OptimizedAutoscale.IsEnabled {
+		pausedAutoScale := expandOptimizedAutoScale(optimizedAutoScaleRaw)
+		pausedAutoScale.IsEnabled = utils.Bool(false)
+
+		log.Printf("[DEBUG] Pausing Optimized AutoScale for Kusto Cluster %q (Resource Group %q)..", name, resourceGroup)
+		if err := kustoClusterUpdate(ctx, client, resourceGroup, name, kusto.ClusterUpdate{
+			ClusterProperties: &kusto.ClusterProperties{
+				OptimizedAutoscale: pausedAutoScale,
+			},
+		}); err != nil {
+			return fmt.Errorf("pausing Optimized AutoScale for Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		// if the SKU can't be updated the Optimized AutoScale configuration is restored, rather than leaving it paused
+		previousAutoScale := *props.OptimizedAutoscale
+		defer func() {
+			if err == nil {
+				return
+			}
+
+			log.Printf("[DEBUG] Restoring Optimized AutoScale for Kusto Cluster %q (Resource Group %q)..", name, resourceGroup)
+			if rollbackErr := kustoClusterUpdate(ctx, client, resourceGroup, name, kusto.ClusterUpdate{
+				ClusterProperties: &kusto.ClusterProperties{
+					OptimizedAutoscale: &previousAutoScale,
+				},
+			}); rollbackErr != nil {
+				err = fmt.Errorf("%+v\n\nrestoring Optimized AutoScale for Kusto Cluster %q (Resource Group %q): %+v", err, name, resourceGroup, rollbackErr)
+			}
+		}()
+	}
+
+	log.Printf("[DEBUG] Updating the SKU for Kusto Cluster %q (Resource Group %q)..", name, resourceGroup)
+	if err := kustoClusterUpdate(ctx, client, resourceGroup, name, kusto.ClusterUpdate{
+		Sku: &sku,
+	}); err != nil {
+		return fmt.Errorf("updating the SKU for Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func kustoClusterUpdate(ctx context.Context, client *kusto.ClustersClient, resourceGroup, name string, parameters kusto.ClusterUpdate) error {
+	future, err := client.Update(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return err
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
+
+func kustoClusterStateRefreshFunc(ctx context.Context, client *kusto.ClustersClient, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Kusto Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if resp.ClusterProperties == nil {
+			return resp, "", nil
+		}
+
+		return resp, string(resp.ClusterProperties.State), nil
+	}
+}
+
 func expandOptimizedAutoScale(input []interface{}) *kusto.OptimizedAutoscale {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -1,8 +1,10 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/kusto/mgmt/2020-09-18/kusto"
@@ -172,6 +174,14 @@ func resourceKustoEventHubDataConnectionCreateUpdate(d *pluginsdk.ResourceData,
 		EventHubConnectionProperties: eventHubDataConnectionProperties,
 	}
 
+	if d.IsNewResource() {
+		// validating the Data Connection up front surfaces issues such as a missing Consumer Group or
+		// missing permissions on the Event Hub, which otherwise leave a Data Connection which doesn't ingest
+		if err := validateKustoEventHubDataConnection(ctx, client, resourceGroup, clusterName, databaseName, name, dataConnection1); err != nil {
+			return err
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, clusterName, databaseName, name, dataConnection1)
 	if err != nil {
 		return fmt.Errorf("Error creating or updating Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q): %+v", name, resourceGroup, clusterName, databaseName, err)
@@ -188,6 +198,10 @@ func resourceKustoEventHubDataConnectionCreateUpdate(d *pluginsdk.ResourceData,
 	}
 
 	if dataConnection, ok := connectionModel.Value.(kusto.EventHubDataConnection); ok {
+		if props := dataConnection.EventHubConnectionProperties; props != nil && props.ProvisioningState == kusto.Failed {
+			return fmt.Errorf("Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q) was provisioned with the state %q", name, resourceGroup, clusterName, databaseName, string(props.ProvisioningState))
+		}
+
 		if dataConnection.ID == nil {
 			return fmt.Errorf("Cannot read ID for Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q): %+v", name, resourceGroup, clusterName, databaseName, err)
 		}
@@ -300,3 +314,37 @@ func expandKustoEventHubDataConnectionProperties(d *pluginsdk.ResourceData) *kus
 
 	return eventHubConnectionProperties
 }
+
+func validateKustoEventHubDataConnection(ctx context.Context, client *kusto.DataConnectionsClient, resourceGroup, clusterName, databaseName, name string, dataConnection kusto.EventHubDataConnection) error {
+	parameters := kusto.DataConnectionValidation{
+		DataConnectionName: utils.String(name),
+		Properties:         dataConnection,
+	}
+
+	future, err := client.DataConnectionValidationMethod(ctx, resourceGroup, clusterName, databaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("validating Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q): %+v", name, resourceGroup, clusterName, databaseName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for validation of Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q): %+v", name, resourceGroup, clusterName, databaseName, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving validation result for Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q): %+v", name, resourceGroup, clusterName, databaseName, err)
+	}
+
+	errors := make([]string, 0)
+	if result.Value != nil {
+		for _, v := range *result.Value {
+			if v.ErrorMessage != nil && *v.ErrorMessage != "" {
+				errors = append(errors, *v.ErrorMessage)
+			}
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("validating Kusto Event Hub Data Connection %q (Resource Group %q, Cluster %q, Database: %q):\n\n- %s", name, resourceGroup, clusterName, databaseName, strings.Join(errors, "\n- "))
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package kusto_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -76,6 +77,18 @@ func TestAccKustoEventHubDataConnection_unboundMapping2(t *testing.T) {
 	})
 }
 
+func TestAccKustoEventHubDataConnection_missingConsumerGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_eventhub_data_connection", "test")
+	r := KustoEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.missingConsumerGroup(data),
+			ExpectError: regexp.MustCompile("validating Kusto Event Hub Data Connection"),
+		},
+	})
+}
+
 func (KustoEventHubDataConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DataConnectionID(state.ID)
 	if err != nil {
@@ -112,6 +125,23 @@ resource "azurerm_kusto_eventhub_data_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r KustoEventHubDataConnectionResource) missingConsumerGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_eventhub_data_connection" "test" {
+  name                = "acctestkedc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+  database_name       = azurerm_kusto_database.test.name
+
+  eventhub_id    = azurerm_eventhub.test.id
+  consumer_group = "acctest-missing"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r KustoEventHubDataConnectionResource) unboundMapping1(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
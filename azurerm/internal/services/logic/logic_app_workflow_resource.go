@@ -1,9 +1,12 @@
 package logic
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
@@ -11,6 +14,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -77,6 +83,30 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 				},
 			},
 
+			// the secret values are resolved from Key Vault at apply time and sent as SecureString parameters,
+			// which the API doesn't return - as such only the Key Vault Secret IDs are stored in the state
+			"key_vault_secret_parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+			},
+
+			// the parameters declared in the Workflow Definition, which the `parameters` and
+			// `key_vault_secret_parameters` are passed into
+			"workflow_parameters": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				// the API returns the parameters with its own formatting and key ordering
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+
 			"workflow_schema": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -146,7 +176,15 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	parameters := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}))
+	parameters, err := expandLogicAppWorkflowAllParameters(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	workflowParameters, err := expandLogicAppWorkflowWorkflowParameters(d.Get("workflow_parameters").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
 
 	workflowSchema := d.Get("workflow_schema").(string)
 	workflowVersion := d.Get("workflow_version").(string)
@@ -160,6 +198,7 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 				"contentVersion": workflowVersion,
 				"actions":        make(map[string]interface{}),
 				"triggers":       make(map[string]interface{}),
+				"parameters":     workflowParameters,
 			},
 			Parameters: parameters,
 		},
@@ -173,6 +212,10 @@ func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("logic_app_integration_account_id"); ok {
+		if err := validateLogicAppWorkflowIntegrationAccount(ctx, meta, v.(string), location, d.Get("integration_service_environment_id").(string)); err != nil {
+			return err
+		}
+
 		properties.WorkflowProperties.IntegrationAccount = &logic.ResourceReference{
 			ID: utils.String(v.(string)),
 		}
@@ -227,19 +270,41 @@ func resourceLogicAppWorkflowUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	parameters := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}))
+	parameters, err := expandLogicAppWorkflowAllParameters(ctx, d, meta)
+	if err != nil {
+		return err
+	}
 	t := d.Get("tags").(map[string]interface{})
 
+	definition := read.WorkflowProperties.Definition
+	if d.HasChange("workflow_parameters") {
+		workflowParameters, err := expandLogicAppWorkflowWorkflowParameters(d.Get("workflow_parameters").(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		if v, ok := definition.(map[string]interface{}); ok {
+			v["parameters"] = workflowParameters
+			definition = v
+		}
+	}
+
 	properties := logic.Workflow{
 		Location: utils.String(location),
 		WorkflowProperties: &logic.WorkflowProperties{
-			Definition: read.WorkflowProperties.Definition,
+			Definition: definition,
 			Parameters: parameters,
 		},
 		Tags: tags.Expand(t),
 	}
 
 	if v, ok := d.GetOk("logic_app_integration_account_id"); ok {
+		if d.HasChange("logic_app_integration_account_id") {
+			if err := validateLogicAppWorkflowIntegrationAccount(ctx, meta, v.(string), location, d.Get("integration_service_environment_id").(string)); err != nil {
+				return err
+			}
+		}
+
 		properties.WorkflowProperties.IntegrationAccount = &logic.ResourceReference{
 			ID: utils.String(v.(string)),
 		}
@@ -308,6 +373,14 @@ func resourceLogicAppWorkflowRead(d *pluginsdk.ResourceData, meta interface{}) e
 			if v, ok := definition.(map[string]interface{}); ok {
 				d.Set("workflow_schema", v["$schema"].(string))
 				d.Set("workflow_version", v["contentVersion"].(string))
+
+				workflowParameters, err := flattenLogicAppWorkflowWorkflowParameters(v["parameters"])
+				if err != nil {
+					return err
+				}
+				if err := d.Set("workflow_parameters", workflowParameters); err != nil {
+					return fmt.Errorf("setting `workflow_parameters`: %+v", err)
+				}
 			}
 		}
 
@@ -372,11 +445,88 @@ func expandLogicAppWorkflowParameters(input map[string]interface{}) map[string]*
 	return output
 }
 
+func expandLogicAppWorkflowAllParameters(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (map[string]*logic.WorkflowParameter, error) {
+	output := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}))
+
+	secretParameters, err := expandLogicAppWorkflowKeyVaultSecretParameters(ctx, meta, d.Get("key_vault_secret_parameters").(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range secretParameters {
+		if _, exists := output[k]; exists {
+			return nil, fmt.Errorf("the parameter %q cannot be specified in both `parameters` and `key_vault_secret_parameters`", k)
+		}
+		output[k] = v
+	}
+
+	return output, nil
+}
+
+func expandLogicAppWorkflowKeyVaultSecretParameters(ctx context.Context, meta interface{}, input map[string]interface{}) (map[string]*logic.WorkflowParameter, error) {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	output := make(map[string]*logic.WorkflowParameter)
+
+	for k, v := range input {
+		secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing the Key Vault Secret ID for the parameter %q: %+v", k, err)
+		}
+		if secretId.NestedItemType != "secrets" {
+			return nil, fmt.Errorf("the Key Vault ID for the parameter %q must be a Secret ID but got %q", k, v.(string))
+		}
+
+		secret, err := client.GetSecret(ctx, secretId.KeyVaultBaseUrl, secretId.Name, secretId.Version)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Key Vault Secret %q for the parameter %q: %+v", secretId.Name, k, err)
+		}
+		if secret.Value == nil {
+			return nil, fmt.Errorf("retrieving Key Vault Secret %q for the parameter %q: `value` was nil", secretId.Name, k)
+		}
+
+		output[k] = &logic.WorkflowParameter{
+			Type:  logic.ParameterTypeSecureString,
+			Value: *secret.Value,
+		}
+	}
+
+	return output, nil
+}
+
+func validateLogicAppWorkflowIntegrationAccount(ctx context.Context, meta interface{}, integrationAccountId string, location string, integrationServiceEnvironmentId string) error {
+	client := meta.(*clients.Client).Logic.IntegrationAccountClient
+
+	id, err := parse.IntegrationAccountID(integrationAccountId)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Logic App Integration Account %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if resp.Location != nil && !strings.EqualFold(azure.NormalizeLocation(*resp.Location), location) {
+		return fmt.Errorf("the Logic App Integration Account %q (Resource Group %q) must be in the same location as the Logic App Workflow (%q) but is in %q", id.Name, id.ResourceGroup, location, *resp.Location)
+	}
+
+	if integrationServiceEnvironmentId != "" && resp.Sku != nil && resp.Sku.Name == logic.IntegrationAccountSkuNameFree {
+		return fmt.Errorf("a Logic App Workflow within an Integration Service Environment cannot be linked to the Logic App Integration Account %q (Resource Group %q) since it uses the %q tier", id.Name, id.ResourceGroup, string(logic.IntegrationAccountSkuNameFree))
+	}
+
+	return nil
+}
+
 func flattenLogicAppWorkflowParameters(input map[string]*logic.WorkflowParameter) map[string]interface{} {
 	output := make(map[string]interface{})
 
 	for k, v := range input {
 		if v != nil {
+			// secure parameters are sourced from `key_vault_secret_parameters` and their values aren't returned
+			if v.Type == logic.ParameterTypeSecureString || v.Type == logic.ParameterTypeSecureObject {
+				continue
+			}
+
 			// we only support string parameters at this time
 			val, ok := v.Value.(string)
 			if !ok {
@@ -390,6 +540,39 @@ func flattenLogicAppWorkflowParameters(input map[string]*logic.WorkflowParameter
 	return output
 }
 
+func expandLogicAppWorkflowWorkflowParameters(input map[string]interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		var parameter interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &parameter); err != nil {
+			return nil, fmt.Errorf("unmarshalling the declaration of the workflow parameter %q: %+v", k, err)
+		}
+		output[k] = parameter
+	}
+
+	return output, nil
+}
+
+func flattenLogicAppWorkflowWorkflowParameters(input interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+
+	parameters, ok := input.(map[string]interface{})
+	if !ok {
+		return output, nil
+	}
+
+	for k, v := range parameters {
+		parameter, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling the declaration of the workflow parameter %q: %+v", k, err)
+		}
+		output[k] = string(parameter)
+	}
+
+	return output, nil
+}
+
 func flattenIPAddresses(input *[]logic.IPAddress) []interface{} {
 	if input == nil {
 		return []interface{}{}
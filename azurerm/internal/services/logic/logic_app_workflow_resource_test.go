@@ -133,6 +133,24 @@ func TestAccLogicAppWorkflow_integrationServiceEnvironment(t *testing.T) {
 	})
 }
 
+func TestAccLogicAppWorkflow_keyVaultSecretParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_workflow", "test")
+	r := LogicAppWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultSecretParameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameters.%").HasValue("1"),
+				check.That(data.ResourceName).Key("key_vault_secret_parameters.%").HasValue("1"),
+				check.That(data.ResourceName).Key("workflow_parameters.%").HasValue("2"),
+			),
+		},
+		data.ImportStep("key_vault_secret_parameters"),
+	})
+}
+
 func (LogicAppWorkflowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -283,3 +301,71 @@ resource "azurerm_logic_app_workflow" "test" {
 }
 `, IntegrationServiceEnvironmentResource{}.basic(data), data.RandomInteger)
 }
+
+func (LogicAppWorkflowResource) keyVaultSecretParameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-logic-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Get",
+      "Delete",
+      "List",
+      "Purge",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%[3]s"
+  value        = "rick-and-morty"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  workflow_parameters = {
+    "environment" = <<PARAM
+{
+  "type": "String",
+  "defaultValue": "test"
+}
+PARAM
+    "password" = jsonencode({
+      type = "SecureString"
+    })
+  }
+
+  parameters = {
+    "environment" = "test"
+  }
+
+  key_vault_secret_parameters = {
+    "password" = azurerm_key_vault_secret.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -7,13 +7,18 @@ import (
 
 type Client struct {
 	AccountsClient *maps.AccountsClient
+	CreatorsClient *maps.CreatorsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := maps.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	creatorsClient := maps.NewCreatorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&creatorsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AccountsClient: &accountsClient,
+		CreatorsClient: &creatorsClient,
 	}
 }
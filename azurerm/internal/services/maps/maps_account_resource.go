@@ -58,6 +58,12 @@ func resourceMapsAccount() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"local_authentication_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"tags": tags.Schema(),
 
 			"x_ms_client_id": {
@@ -110,6 +116,9 @@ func resourceMapsAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Sku: &maps.Sku{
 			Name: maps.Name(sku),
 		},
+		Properties: &maps.AccountProperties{
+			DisableLocalAuth: utils.Bool(!d.Get("local_authentication_enabled").(bool)),
+		},
 		Tags: tags.Expand(t),
 	}
 
@@ -158,6 +167,12 @@ func resourceMapsAccountRead(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 	if props := resp.Properties; props != nil {
 		d.Set("x_ms_client_id", props.UniqueID)
+
+		localAuthenticationEnabled := true
+		if props.DisableLocalAuth != nil {
+			localAuthenticationEnabled = !*props.DisableLocalAuth
+		}
+		d.Set("local_authentication_enabled", localAuthenticationEnabled)
 	}
 
 	keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
@@ -0,0 +1,159 @@
+package maps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/maps/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MapsCreatorResource struct {
+}
+
+func TestAccMapsCreator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maps_creator", "test")
+	r := MapsCreatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMapsCreator_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maps_creator", "test")
+	r := MapsCreatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMapsCreator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maps_creator", "test")
+	r := MapsCreatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_units").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_units").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_units").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MapsCreatorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CreatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Maps.CreatorsClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (MapsCreatorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_maps_account" "test" {
+  name                = "accMapsAccount-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "G2"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r MapsCreatorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maps_creator" "test" {
+  name            = "accMapsCreator-%d"
+  maps_account_id = azurerm_maps_account.test.id
+  location        = azurerm_resource_group.test.location
+  storage_units   = 1
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MapsCreatorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maps_creator" "import" {
+  name            = azurerm_maps_creator.test.name
+  maps_account_id = azurerm_maps_creator.test.maps_account_id
+  location        = azurerm_maps_creator.test.location
+  storage_units   = azurerm_maps_creator.test.storage_units
+}
+`, r.basic(data))
+}
+
+func (r MapsCreatorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maps_creator" "test" {
+  name            = "accMapsCreator-%d"
+  maps_account_id = azurerm_maps_account.test.id
+  location        = azurerm_resource_group.test.location
+  storage_units   = 2
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -1,3 +1,4 @@
 package maps
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Account -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Creator -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Maps/accounts/account1/creators/creator1
@@ -6,19 +6,27 @@ import (
 )
 
 type Client struct {
-	AccountClient  *netapp.AccountsClient
-	PoolClient     *netapp.PoolsClient
-	VolumeClient   *netapp.VolumesClient
-	SnapshotClient *netapp.SnapshotsClient
+	AccountClient      *netapp.AccountsClient
+	BackupPolicyClient *netapp.BackupPoliciesClient
+	PoolClient         *netapp.PoolsClient
+	VaultsClient       *netapp.VaultsClient
+	VolumeClient       *netapp.VolumesClient
+	SnapshotClient     *netapp.SnapshotsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountClient := netapp.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountClient.Client, o.ResourceManagerAuthorizer)
 
+	backupPolicyClient := netapp.NewBackupPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&backupPolicyClient.Client, o.ResourceManagerAuthorizer)
+
 	poolClient := netapp.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&poolClient.Client, o.ResourceManagerAuthorizer)
 
+	vaultsClient := netapp.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
+
 	volumeClient := netapp.NewVolumesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&volumeClient.Client, o.ResourceManagerAuthorizer)
 
@@ -26,9 +34,11 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&snapshotClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AccountClient:  &accountClient,
-		PoolClient:     &poolClient,
-		VolumeClient:   &volumeClient,
-		SnapshotClient: &snapshotClient,
+		AccountClient:      &accountClient,
+		BackupPolicyClient: &backupPolicyClient,
+		PoolClient:         &poolClient,
+		VaultsClient:       &vaultsClient,
+		VolumeClient:       &volumeClient,
+		SnapshotClient:     &snapshotClient,
 	}
 }
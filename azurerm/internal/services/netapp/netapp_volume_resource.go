@@ -218,6 +218,27 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				},
 			},
 
+			"data_protection_backup_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"backup_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: netAppValidate.BackupPolicyID,
+						},
+
+						"policy_enforced": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
 			"data_protection_replication": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -306,6 +327,19 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 	dataProtectionReplicationRaw := d.Get("data_protection_replication").([]interface{})
 	dataProtectionReplication := expandNetAppVolumeDataProtectionReplication(dataProtectionReplicationRaw)
 
+	// the backup vault is provisioned by the service, so we look it up from the NetApp Account
+	if v, ok := d.GetOk("data_protection_backup_policy"); ok {
+		vaultId, err := netAppAccountVaultID(ctx, meta.(*clients.Client).NetApp.VaultsClient, resourceGroup, accountName)
+		if err != nil {
+			return err
+		}
+		dataProtectionReplication.Backup = expandNetAppVolumeDataProtectionBackupPolicy(v.([]interface{}), *vaultId)
+	} else if !d.IsNewResource() && d.HasChange("data_protection_backup_policy") {
+		dataProtectionReplication.Backup = &netapp.VolumeBackupProperties{
+			BackupEnabled: utils.Bool(false),
+		}
+	}
+
 	authorizeReplication := false
 	volumeType := ""
 	if dataProtectionReplication != nil && dataProtectionReplication.Replication != nil && strings.ToLower(string(dataProtectionReplication.Replication.EndpointType)) == "dst" {
@@ -495,6 +529,9 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection)); err != nil {
 			return fmt.Errorf("setting `data_protection_replication`: %+v", err)
 		}
+		if err := d.Set("data_protection_backup_policy", flattenNetAppVolumeDataProtectionBackupPolicy(props.DataProtection)); err != nil {
+			return fmt.Errorf("setting `data_protection_backup_policy`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -811,6 +848,21 @@ func expandNetAppVolumeDataProtectionReplication(input []interface{}) *netapp.Vo
 	}
 }
 
+func expandNetAppVolumeDataProtectionBackupPolicy(input []interface{}, vaultId string) *netapp.VolumeBackupProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &netapp.VolumeBackupProperties{
+		BackupEnabled:  utils.Bool(true),
+		BackupPolicyID: utils.String(v["backup_policy_id"].(string)),
+		PolicyEnforced: utils.Bool(v["policy_enforced"].(bool)),
+		VaultID:        utils.String(vaultId),
+	}
+}
+
 func flattenNetAppVolumeExportPolicyRule(input *netapp.VolumePropertiesExportPolicy) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil || input.Rules == nil {
@@ -914,6 +966,48 @@ func flattenNetAppVolumeDataProtectionReplication(input *netapp.VolumeProperties
 	}
 }
 
+func flattenNetAppVolumeDataProtectionBackupPolicy(input *netapp.VolumePropertiesDataProtection) []interface{} {
+	if input == nil || input.Backup == nil || input.Backup.BackupEnabled == nil || !*input.Backup.BackupEnabled {
+		return []interface{}{}
+	}
+
+	backupPolicyId := ""
+	if input.Backup.BackupPolicyID != nil {
+		if id, err := parse.BackupPolicyID(*input.Backup.BackupPolicyID); err == nil {
+			backupPolicyId = id.ID()
+		}
+	}
+
+	policyEnforced := false
+	if input.Backup.PolicyEnforced != nil {
+		policyEnforced = *input.Backup.PolicyEnforced
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"backup_policy_id": backupPolicyId,
+			"policy_enforced":  policyEnforced,
+		},
+	}
+}
+
+func netAppAccountVaultID(ctx context.Context, client *netapp.VaultsClient, resourceGroup, accountName string) (*string, error) {
+	vaults, err := client.List(ctx, resourceGroup, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Backup Vaults for NetApp Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+	}
+
+	if vaults.Value != nil {
+		for _, vault := range *vaults.Value {
+			if vault.ID != nil && *vault.ID != "" {
+				return vault.ID, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no Backup Vault was found for NetApp Account %q (Resource Group %q)", accountName, resourceGroup)
+}
+
 func translateTFSchedule(scheduleName string) string {
 	if strings.EqualFold(scheduleName, "10minutes") {
 		return "_10minutely"
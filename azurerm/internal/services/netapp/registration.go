@@ -31,9 +31,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_netapp_account":  resourceNetAppAccount(),
-		"azurerm_netapp_pool":     resourceNetAppPool(),
-		"azurerm_netapp_volume":   resourceNetAppVolume(),
-		"azurerm_netapp_snapshot": resourceNetAppSnapshot(),
+		"azurerm_netapp_account":       resourceNetAppAccount(),
+		"azurerm_netapp_backup_policy": resourceNetAppBackupPolicy(),
+		"azurerm_netapp_pool":          resourceNetAppPool(),
+		"azurerm_netapp_volume":        resourceNetAppVolume(),
+		"azurerm_netapp_snapshot":      resourceNetAppSnapshot(),
 	}
 }
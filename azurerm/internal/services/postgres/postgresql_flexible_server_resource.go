@@ -1,8 +1,10 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,6 +30,15 @@ const (
 	ServerMaintenanceWindowDisabled = "Disabled"
 )
 
+const (
+	flexibleServerConfigurationIndexTuningMode            = "index_tuning.mode"
+	flexibleServerConfigurationQueryStoreCaptureMode      = "pg_qs.query_capture_mode"
+	flexibleServerConfigurationQueryStoreWaitSamplingMode = "pgms_wait_sampling.query_capture_mode"
+
+	flexibleServerIndexTuningModeOff        = "OFF"
+	flexibleServerQueryStoreCaptureModeNone = "NONE"
+)
+
 func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePostgresqlFlexibleServerCreate,
@@ -174,6 +185,46 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				},
 			},
 
+			"query_store": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query_capture_mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ALL",
+								"TOP",
+								"NONE",
+							}, false),
+						},
+
+						"wait_sampling_capture_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  flexibleServerQueryStoreCaptureModeNone,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ALL",
+								"NONE",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"index_tuning_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					flexibleServerIndexTuningModeOff,
+					"REPORT",
+				}, false),
+			},
+
 			"backup_retention_days": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -181,6 +232,13 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(7, 35),
 			},
 
+			"geo_redundant_backup_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"high_availability": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -222,6 +280,7 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	configurationsClient := meta.(*clients.Client).Postgres.FlexibleServersConfigurationsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -296,6 +355,11 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		parameters.ServerProperties.AdministratorLoginPassword = utils.String(v.(string))
 	}
 
+	// Geo-Redundant Backups can only be configured when the Server is created
+	if d.Get("geo_redundant_backup_enabled").(bool) {
+		parameters.ServerProperties.Backup.GeoRedundantBackup = postgresqlflexibleservers.GeoRedundantBackupEnumEnabled
+	}
+
 	if v, ok := d.GetOk("zone"); ok && v.(string) != "" {
 		parameters.ServerProperties.AvailabilityZone = utils.String(v.(string))
 	}
@@ -341,11 +405,26 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	d.SetId(id.ID())
 
+	// `query_store` and `index_tuning_mode` are Server Parameters, which can only be set once the Server exists
+	configurations := make(map[string]string)
+	if v, ok := d.GetOk("query_store"); ok {
+		for k, v := range expandFlexibleServerQueryStore(v.([]interface{})) {
+			configurations[k] = v
+		}
+	}
+	if v, ok := d.GetOk("index_tuning_mode"); ok {
+		configurations[flexibleServerConfigurationIndexTuningMode] = v.(string)
+	}
+	if err := updateFlexibleServerConfigurations(ctx, configurationsClient, id.ResourceGroup, id.Name, configurations); err != nil {
+		return fmt.Errorf("updating Server Parameters for Postgresql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	return resourcePostgresqlFlexibleServerRead(d, meta)
 }
 
 func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	configurationsClient := meta.(*clients.Client).Postgres.FlexibleServersConfigurationsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -394,6 +473,7 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 
 		if backup := props.Backup; backup != nil {
 			d.Set("backup_retention_days", backup.BackupRetentionDays)
+			d.Set("geo_redundant_backup_enabled", backup.GeoRedundantBackup == postgresqlflexibleservers.GeoRedundantBackupEnumEnabled)
 		}
 
 		if err := d.Set("high_availability", flattenFlexibleServerHighAvailability(props.HighAvailability)); err != nil {
@@ -408,11 +488,40 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 
 	d.Set("sku_name", sku)
 
+	// these Server Parameters can also be managed using `azurerm_postgresql_flexible_server_configuration`, so they're
+	// only retrieved when they're managed by this resource
+	if _, ok := d.GetOk("query_store"); ok {
+		queryCaptureMode, err := getFlexibleServerConfigurationValue(ctx, configurationsClient, id.ResourceGroup, id.Name, flexibleServerConfigurationQueryStoreCaptureMode)
+		if err != nil {
+			return fmt.Errorf("retrieving Server Parameter %q for Postgresql Flexible Server %q (Resource Group %q): %+v", flexibleServerConfigurationQueryStoreCaptureMode, id.Name, id.ResourceGroup, err)
+		}
+		waitSamplingCaptureMode, err := getFlexibleServerConfigurationValue(ctx, configurationsClient, id.ResourceGroup, id.Name, flexibleServerConfigurationQueryStoreWaitSamplingMode)
+		if err != nil {
+			return fmt.Errorf("retrieving Server Parameter %q for Postgresql Flexible Server %q (Resource Group %q): %+v", flexibleServerConfigurationQueryStoreWaitSamplingMode, id.Name, id.ResourceGroup, err)
+		}
+		if err := d.Set("query_store", flattenFlexibleServerQueryStore(queryCaptureMode, waitSamplingCaptureMode)); err != nil {
+			return fmt.Errorf("setting `query_store`: %+v", err)
+		}
+	}
+
+	if _, ok := d.GetOk("index_tuning_mode"); ok {
+		// the `index_tuning.mode` Server Parameter isn't available on all Servers, in which case it's Off
+		indexTuningMode, err := getFlexibleServerConfigurationValue(ctx, configurationsClient, id.ResourceGroup, id.Name, flexibleServerConfigurationIndexTuningMode)
+		if err != nil {
+			return fmt.Errorf("retrieving Server Parameter %q for Postgresql Flexible Server %q (Resource Group %q): %+v", flexibleServerConfigurationIndexTuningMode, id.Name, id.ResourceGroup, err)
+		}
+		if indexTuningMode == "" {
+			indexTuningMode = flexibleServerIndexTuningModeOff
+		}
+		d.Set("index_tuning_mode", indexTuningMode)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+	configurationsClient := meta.(*clients.Client).Postgres.FlexibleServersConfigurationsClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -466,6 +575,20 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		return fmt.Errorf("waiting for the update of the Postgresql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
+
+	configurations := make(map[string]string)
+	if v, ok := d.GetOk("query_store"); ok && d.HasChange("query_store") {
+		for k, v := range expandFlexibleServerQueryStore(v.([]interface{})) {
+			configurations[k] = v
+		}
+	}
+	if v, ok := d.GetOk("index_tuning_mode"); ok && d.HasChange("index_tuning_mode") {
+		configurations[flexibleServerConfigurationIndexTuningMode] = v.(string)
+	}
+	if err := updateFlexibleServerConfigurations(ctx, configurationsClient, id.ResourceGroup, id.Name, configurations); err != nil {
+		return fmt.Errorf("updating Server Parameters for Postgresql Flexible Server %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	return resourcePostgresqlFlexibleServerRead(d, meta)
 }
 
@@ -650,3 +773,77 @@ func flattenFlexibleServerHighAvailability(ha *postgresqlflexibleservers.HighAva
 		},
 	}
 }
+
+func expandFlexibleServerQueryStore(input []interface{}) map[string]string {
+	// the Server Parameters are only updated when `query_store` is specified, removing the block leaves them unchanged
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return map[string]string{
+		flexibleServerConfigurationQueryStoreCaptureMode:      v["query_capture_mode"].(string),
+		flexibleServerConfigurationQueryStoreWaitSamplingMode: v["wait_sampling_capture_mode"].(string),
+	}
+}
+
+func flattenFlexibleServerQueryStore(queryCaptureMode, waitSamplingCaptureMode string) []interface{} {
+	if queryCaptureMode == "" {
+		queryCaptureMode = flexibleServerQueryStoreCaptureModeNone
+	}
+	if waitSamplingCaptureMode == "" {
+		waitSamplingCaptureMode = flexibleServerQueryStoreCaptureModeNone
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"query_capture_mode":         queryCaptureMode,
+			"wait_sampling_capture_mode": waitSamplingCaptureMode,
+		},
+	}
+}
+
+func getFlexibleServerConfigurationValue(ctx context.Context, client *postgresqlflexibleservers.ConfigurationsClient, resourceGroup, serverName, name string) (string, error) {
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if props := resp.ConfigurationProperties; props != nil && props.Value != nil {
+		return strings.ToUpper(*props.Value), nil
+	}
+
+	return "", nil
+}
+
+func updateFlexibleServerConfigurations(ctx context.Context, client *postgresqlflexibleservers.ConfigurationsClient, resourceGroup, serverName string, configurations map[string]string) error {
+	// sorted so that the Server Parameters are updated in a consistent order
+	names := make([]string, 0, len(configurations))
+	for name := range configurations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		props := postgresqlflexibleservers.Configuration{
+			ConfigurationProperties: &postgresqlflexibleservers.ConfigurationProperties{
+				Value:  utils.String(configurations[name]),
+				Source: utils.String("user-override"),
+			},
+		}
+
+		future, err := client.Update(ctx, resourceGroup, serverName, name, props)
+		if err != nil {
+			return fmt.Errorf("updating Server Parameter %q: %+v", name, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for update of Server Parameter %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
@@ -174,6 +174,55 @@ func TestAccPostgresqlflexibleServer_updateSku(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlflexibleServer_queryStoreAndIndexTuning(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+		{
+			Config: r.queryStoreAndIndexTuning(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query_store.0.query_capture_mode").HasValue("TOP"),
+				check.That(data.ResourceName).Key("index_tuning_mode").HasValue("REPORT"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "query_store", "index_tuning_mode"),
+		{
+			Config: r.queryStoreAndIndexTuningDisabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("query_store.0.query_capture_mode").HasValue("NONE"),
+				check.That(data.ResourceName).Key("index_tuning_mode").HasValue("OFF"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "query_store", "index_tuning_mode"),
+	})
+}
+
+func TestAccPostgresqlflexibleServer_geoRedundantBackup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoRedundantBackup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("geo_redundant_backup_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+	})
+}
+
 func TestAccPostgresqlflexibleServer_pitr(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
 	r := PostgresqlFlexibleServerResource{}
@@ -469,6 +518,71 @@ resource "azurerm_postgresql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r PostgresqlFlexibleServerResource) queryStoreAndIndexTuning(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  storage_mb             = 32768
+  version                = "12"
+  sku_name               = "GP_Standard_D2s_v3"
+  index_tuning_mode      = "REPORT"
+
+  query_store {
+    query_capture_mode         = "TOP"
+    wait_sampling_capture_mode = "ALL"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PostgresqlFlexibleServerResource) queryStoreAndIndexTuningDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  storage_mb             = 32768
+  version                = "12"
+  sku_name               = "GP_Standard_D2s_v3"
+  index_tuning_mode      = "OFF"
+
+  query_store {
+    query_capture_mode         = "NONE"
+    wait_sampling_capture_mode = "NONE"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PostgresqlFlexibleServerResource) geoRedundantBackup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                         = "acctest-fs-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  administrator_login          = "adminTerraform"
+  administrator_password       = "QAZwsx123"
+  storage_mb                   = 32768
+  version                      = "12"
+  sku_name                     = "GP_Standard_D2s_v3"
+  backup_retention_days        = 7
+  geo_redundant_backup_enabled = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r PostgresqlFlexibleServerResource) pitr(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
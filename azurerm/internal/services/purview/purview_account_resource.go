@@ -102,6 +102,29 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"managed_resources": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"event_hub_namespace_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"storage_account_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"atlas_kafka_endpoint_primary_connection_string": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -208,6 +231,10 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("guardian_endpoint", endpoints.Guardian)
 			d.Set("scan_endpoint", endpoints.Scan)
 		}
+
+		if err := d.Set("managed_resources", flattenPurviewAccountManagedResources(props.ManagedResources)); err != nil {
+			return fmt.Errorf("setting `managed_resources`: %+v", err)
+		}
 	}
 
 	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
@@ -288,3 +315,32 @@ func flattenPurviewAccountIdentity(identity *purview.Identity) interface{} {
 		},
 	}
 }
+
+func flattenPurviewAccountManagedResources(input *purview.AccountPropertiesManagedResources) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	eventHubNamespaceId := ""
+	if input.EventHubNamespace != nil {
+		eventHubNamespaceId = *input.EventHubNamespace
+	}
+
+	resourceGroupId := ""
+	if input.ResourceGroup != nil {
+		resourceGroupId = *input.ResourceGroup
+	}
+
+	storageAccountId := ""
+	if input.StorageAccount != nil {
+		storageAccountId = *input.StorageAccount
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"event_hub_namespace_id": eventHubNamespaceId,
+			"resource_group_id":      resourceGroupId,
+			"storage_account_id":     storageAccountId,
+		},
+	}
+}
@@ -24,6 +24,9 @@ func TestAccPurviewAccount_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resources.0.event_hub_namespace_id").Exists(),
+				check.That(data.ResourceName).Key("managed_resources.0.resource_group_id").Exists(),
+				check.That(data.ResourceName).Key("managed_resources.0.storage_account_id").Exists(),
 			),
 		},
 		data.ImportStep(),
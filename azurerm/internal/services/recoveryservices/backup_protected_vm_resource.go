@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2019-05-13/backup"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -14,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -59,6 +61,26 @@ func resourceRecoveryServicesBackupProtectedVM() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"exclude_disk_luns": {
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"include_disk_luns"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeInt,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+
+			"include_disk_luns": {
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"exclude_disk_luns"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeInt,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -86,6 +108,14 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *pluginsdk.Resource
 		return fmt.Errorf("[ERROR] parsed source_vm_id '%s' doesn't contain 'virtualMachines'", vmId)
 	}
 
+	excludeDiskLuns := d.Get("exclude_disk_luns").(*pluginsdk.Set).List()
+	includeDiskLuns := d.Get("include_disk_luns").(*pluginsdk.Set).List()
+	if len(excludeDiskLuns) > 0 || len(includeDiskLuns) > 0 {
+		if err := validateRecoveryServicesBackupProtectedVMDiskLuns(ctx, meta.(*clients.Client).Compute.VMClient, parsedVmId.ResourceGroup, vmName, append(excludeDiskLuns, includeDiskLuns...)); err != nil {
+			return err
+		}
+	}
+
 	protectedItemName := fmt.Sprintf("VM;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
 	containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
 
@@ -113,6 +143,9 @@ func resourceRecoveryServicesBackupProtectedVMCreateUpdate(d *pluginsdk.Resource
 			SourceResourceID:  utils.String(vmId),
 			FriendlyName:      utils.String(vmName),
 			VirtualMachineID:  utils.String(vmId),
+			ExtendedProperties: &backup.ExtendedProperties{
+				DiskExclusionProperties: expandDiskExclusionProperties(includeDiskLuns, excludeDiskLuns),
+			},
 		},
 	}
 
@@ -168,6 +201,17 @@ func resourceRecoveryServicesBackupProtectedVMRead(d *pluginsdk.ResourceData, me
 			if v := vm.PolicyID; v != nil {
 				d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
 			}
+
+			var excludeDiskLuns, includeDiskLuns []interface{}
+			if v := vm.ExtendedProperties; v != nil && v.DiskExclusionProperties != nil {
+				excludeDiskLuns, includeDiskLuns = flattenDiskExclusionProperties(v.DiskExclusionProperties)
+			}
+			if err := d.Set("exclude_disk_luns", excludeDiskLuns); err != nil {
+				return fmt.Errorf("setting `exclude_disk_luns`: %+v", err)
+			}
+			if err := d.Set("include_disk_luns", includeDiskLuns); err != nil {
+				return fmt.Errorf("setting `include_disk_luns`: %+v", err)
+			}
 		}
 	}
 
@@ -278,3 +322,66 @@ func resourceRecoveryServicesBackupProtectedVMRefreshFunc(ctx context.Context, c
 		return resp, "Found", nil
 	}
 }
+
+func validateRecoveryServicesBackupProtectedVMDiskLuns(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, vmName string, luns []interface{}) error {
+	vm, err := client.Get(ctx, resourceGroup, vmName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Virtual Machine %q (Resource Group %q) to validate the disk LUNs: %+v", vmName, resourceGroup, err)
+	}
+
+	attachedLuns := make(map[int32]bool)
+	if props := vm.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.DataDisks != nil {
+		for _, disk := range *props.StorageProfile.DataDisks {
+			if disk.Lun != nil {
+				attachedLuns[*disk.Lun] = true
+			}
+		}
+	}
+
+	for _, lun := range luns {
+		if !attachedLuns[int32(lun.(int))] {
+			return fmt.Errorf("no data disk is attached to Virtual Machine %q (Resource Group %q) at LUN %d", vmName, resourceGroup, lun.(int))
+		}
+	}
+
+	return nil
+}
+
+func expandDiskExclusionProperties(includeDiskLuns, excludeDiskLuns []interface{}) *backup.DiskExclusionProperties {
+	if len(includeDiskLuns) > 0 {
+		return &backup.DiskExclusionProperties{
+			DiskLunList:     utils.ExpandInt32Slice(includeDiskLuns),
+			IsInclusionList: utils.Bool(true),
+		}
+	}
+
+	if len(excludeDiskLuns) > 0 {
+		return &backup.DiskExclusionProperties{
+			DiskLunList:     utils.ExpandInt32Slice(excludeDiskLuns),
+			IsInclusionList: utils.Bool(false),
+		}
+	}
+
+	// an empty list resets any previous selection so that all disks are backed up
+	return &backup.DiskExclusionProperties{
+		DiskLunList:     &[]int32{},
+		IsInclusionList: utils.Bool(false),
+	}
+}
+
+func flattenDiskExclusionProperties(input *backup.DiskExclusionProperties) ([]interface{}, []interface{}) {
+	if input == nil || input.DiskLunList == nil {
+		return []interface{}{}, []interface{}{}
+	}
+
+	luns := make([]interface{}, 0)
+	for _, lun := range *input.DiskLunList {
+		luns = append(luns, int(lun))
+	}
+
+	if input.IsInclusionList != nil && *input.IsInclusionList {
+		return []interface{}{}, luns
+	}
+
+	return luns, []interface{}{}
+}
@@ -42,5 +42,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_site_recovery_protection_container_mapping": resourceSiteRecoveryProtectionContainerMapping(),
 		"azurerm_site_recovery_replicated_vm":                resourceSiteRecoveryReplicatedVM(),
 		"azurerm_site_recovery_replication_policy":           resourceSiteRecoveryReplicationPolicy(),
+		"azurerm_site_recovery_vmware_replicated_vm":         resourceSiteRecoveryVMWareReplicatedVM(),
 	}
 }
@@ -0,0 +1,684 @@
+package recoveryservices
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-07-10/siterecovery"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/recoveryservices/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceSiteRecoveryVMWareReplicatedVM() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSiteRecoveryVMWareReplicatedVMCreate,
+		Read:   resourceSiteRecoveryVMWareReplicatedVMRead,
+		Update: resourceSiteRecoveryVMWareReplicatedVMUpdate,
+		Delete: resourceSiteRecoveryVMWareReplicatedVMDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parseSiteRecoveryVMWareReplicatedVMID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(120 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(80 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_recovery_fabric_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_recovery_protection_container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"recovery_replication_policy_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"source_vm_discovery_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"appliance_process_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"run_as_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"multi_vm_group_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"default_log_storage_account_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ExactlyOneOf:     []string{"default_log_storage_account_id", "managed_disk"},
+				RequiredWith:     []string{"default_target_disk_type"},
+			},
+
+			"default_target_disk_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(siteRecoveryVMWareReplicatedVMDiskTypes(), false),
+				RequiredWith: []string{"default_log_storage_account_id"},
+			},
+
+			"default_target_disk_encryption_set_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				RequiredWith:     []string{"default_log_storage_account_id"},
+			},
+
+			"managed_disk": {
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Set:          resourceSiteRecoveryVMWareReplicatedVMDiskHash,
+				ExactlyOneOf: []string{"default_log_storage_account_id", "managed_disk"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"disk_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"log_storage_account_id": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"target_disk_type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(siteRecoveryVMWareReplicatedVMDiskTypes(), false),
+						},
+
+						"target_disk_encryption_set_id": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+					},
+				},
+			},
+
+			"target_resource_group_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"target_vm_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_vm_size": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_network_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"target_subnet_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"test_network_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				RequiredWith:     []string{"test_subnet_name"},
+			},
+
+			"test_subnet_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"test_network_id"},
+			},
+
+			"target_availability_set_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"target_availability_zone"},
+			},
+
+			"target_availability_zone": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"target_availability_set_id"},
+			},
+
+			"target_proximity_placement_group_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"target_boot_diagnostics_storage_account_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"license_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(siterecovery.LicenseTypeNotSpecified),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(siterecovery.LicenseTypeNotSpecified),
+					string(siterecovery.LicenseTypeNoLicenseType),
+					string(siterecovery.LicenseTypeWindowsServer),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceSiteRecoveryVMWareReplicatedVMCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	resGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	name := d.Get("name").(string)
+	fabricName := d.Get("source_recovery_fabric_name").(string)
+	protectionContainerName := d.Get("source_recovery_protection_container_name").(string)
+
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	existing, err := client.Get(ctx, fabricName, protectionContainerName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_site_recovery_vmware_replicated_vm", handleAzureSdkForGoBug2824(*existing.ID))
+	}
+
+	providerInput := siterecovery.InMageRcmEnableProtectionInput{
+		FabricDiscoveryMachineID: utils.String(d.Get("source_vm_discovery_machine_id").(string)),
+		ProcessServerID:          utils.String(d.Get("appliance_process_server_id").(string)),
+		TargetResourceGroupID:    utils.String(d.Get("target_resource_group_id").(string)),
+		TargetVMName:             utils.String(d.Get("target_vm_name").(string)),
+		TargetNetworkID:          utils.String(d.Get("target_network_id").(string)),
+		TargetSubnetName:         utils.String(d.Get("target_subnet_name").(string)),
+		LicenseType:              siterecovery.LicenseType(d.Get("license_type").(string)),
+	}
+
+	if v, ok := d.GetOk("run_as_account_id"); ok {
+		providerInput.RunAsAccountID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("multi_vm_group_name"); ok {
+		providerInput.MultiVMGroupName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_log_storage_account_id"); ok {
+		providerInput.DisksDefault = &siterecovery.InMageRcmDisksDefaultInput{
+			LogStorageAccountID: utils.String(v.(string)),
+			DiskType:            siterecovery.DiskAccountType(d.Get("default_target_disk_type").(string)),
+		}
+		if v, ok := d.GetOk("default_target_disk_encryption_set_id"); ok {
+			providerInput.DisksDefault.DiskEncryptionSetID = utils.String(v.(string))
+		}
+	} else {
+		providerInput.DisksToInclude = expandSiteRecoveryVMWareReplicatedVMDisks(d.Get("managed_disk").(*pluginsdk.Set).List())
+	}
+
+	if v, ok := d.GetOk("target_vm_size"); ok {
+		providerInput.TargetVMSize = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("test_network_id"); ok {
+		providerInput.TestNetworkID = utils.String(v.(string))
+		providerInput.TestSubnetName = utils.String(d.Get("test_subnet_name").(string))
+	}
+
+	if v, ok := d.GetOk("target_availability_set_id"); ok {
+		providerInput.TargetAvailabilitySetID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_availability_zone"); ok {
+		providerInput.TargetAvailabilityZone = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_proximity_placement_group_id"); ok {
+		providerInput.TargetProximityPlacementGroupID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_boot_diagnostics_storage_account_id"); ok {
+		providerInput.TargetBootDiagnosticsStorageAccountID = utils.String(v.(string))
+	}
+
+	parameters := siterecovery.EnableProtectionInput{
+		Properties: &siterecovery.EnableProtectionInputProperties{
+			PolicyID:                utils.String(d.Get("recovery_replication_policy_id").(string)),
+			ProviderSpecificDetails: providerInput,
+		},
+	}
+
+	future, err := client.Create(ctx, fabricName, protectionContainerName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	resp, err := client.Get(ctx, fabricName, protectionContainerName, name)
+	if err != nil {
+		return fmt.Errorf("retrieving site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("retrieving site recovery vmware replicated vm %s (vault %s): ID was nil", name, vaultName)
+	}
+
+	d.SetId(handleAzureSdkForGoBug2824(*resp.ID))
+
+	return resourceSiteRecoveryVMWareReplicatedVMRead(d, meta)
+}
+
+func resourceSiteRecoveryVMWareReplicatedVMUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	fabricName := id.Path["replicationFabrics"]
+	protectionContainerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationProtectedItems"]
+
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	providerInput := siterecovery.InMageRcmUpdateReplicationProtectedItemInput{
+		TargetVMName:          utils.String(d.Get("target_vm_name").(string)),
+		TargetResourceGroupID: utils.String(d.Get("target_resource_group_id").(string)),
+		TargetNetworkID:       utils.String(d.Get("target_network_id").(string)),
+		LicenseType:           siterecovery.LicenseType(d.Get("license_type").(string)),
+	}
+
+	if v, ok := d.GetOk("target_vm_size"); ok {
+		providerInput.TargetVMSize = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("test_network_id"); ok {
+		providerInput.TestNetworkID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_availability_set_id"); ok {
+		providerInput.TargetAvailabilitySetID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_availability_zone"); ok {
+		providerInput.TargetAvailabilityZone = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_proximity_placement_group_id"); ok {
+		providerInput.TargetProximityPlacementGroupID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_boot_diagnostics_storage_account_id"); ok {
+		providerInput.TargetBootDiagnosticsStorageAccountID = utils.String(v.(string))
+	}
+
+	// the subnets are configured on the primary NIC, which must be specified alongside the networks they belong to
+	if d.HasChanges("target_network_id", "target_subnet_name", "test_network_id", "test_subnet_name") {
+		existing, err := client.Get(ctx, fabricName, protectionContainerName, name)
+		if err != nil {
+			return fmt.Errorf("retrieving site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+		}
+
+		var primaryNic *siterecovery.InMageRcmNicDetails
+		if props := existing.Properties; props != nil {
+			if details, ok := props.ProviderSpecificDetails.AsInMageRcmReplicationDetails(); ok && details != nil {
+				primaryNic = findSiteRecoveryVMWareReplicatedVMPrimaryNic(details.VMNics)
+			}
+		}
+		if primaryNic == nil || primaryNic.NicID == nil {
+			return fmt.Errorf("updating site recovery vmware replicated vm %s (vault %s): the primary NIC was not found", name, vaultName)
+		}
+
+		nicInput := siterecovery.InMageRcmNicInput{
+			NicID:            primaryNic.NicID,
+			IsPrimaryNic:     utils.String("true"),
+			TargetSubnetName: utils.String(d.Get("target_subnet_name").(string)),
+		}
+		if v, ok := d.GetOk("test_subnet_name"); ok {
+			nicInput.TestSubnetName = utils.String(v.(string))
+		}
+		providerInput.VMNics = &[]siterecovery.InMageRcmNicInput{nicInput}
+	}
+
+	parameters := siterecovery.UpdateReplicationProtectedItemInput{
+		Properties: &siterecovery.UpdateReplicationProtectedItemInputProperties{
+			ProviderSpecificDetails: providerInput,
+		},
+	}
+
+	future, err := client.Update(ctx, fabricName, protectionContainerName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	return resourceSiteRecoveryVMWareReplicatedVMRead(d, meta)
+}
+
+func resourceSiteRecoveryVMWareReplicatedVMRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	fabricName := id.Path["replicationFabrics"]
+	protectionContainerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationProtectedItems"]
+
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resp, err := client.Get(ctx, fabricName, protectionContainerName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("making Read request on site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("recovery_vault_name", vaultName)
+	d.Set("source_recovery_fabric_name", fabricName)
+	d.Set("source_recovery_protection_container_name", protectionContainerName)
+
+	if props := resp.Properties; props != nil {
+		d.Set("recovery_replication_policy_id", props.PolicyID)
+
+		if details, ok := props.ProviderSpecificDetails.AsInMageRcmReplicationDetails(); ok && details != nil {
+			d.Set("source_vm_discovery_machine_id", details.FabricDiscoveryMachineID)
+			d.Set("appliance_process_server_id", details.ProcessServerID)
+			d.Set("run_as_account_id", details.RunAsAccountID)
+			d.Set("multi_vm_group_name", details.MultiVMGroupName)
+			d.Set("target_resource_group_id", details.TargetResourceGroupID)
+			d.Set("target_vm_name", details.TargetVMName)
+			d.Set("target_vm_size", details.TargetVMSize)
+			d.Set("target_network_id", details.TargetNetworkID)
+			d.Set("test_network_id", details.TestNetworkID)
+
+			targetSubnetName := ""
+			testSubnetName := ""
+			if nic := findSiteRecoveryVMWareReplicatedVMPrimaryNic(details.VMNics); nic != nil {
+				if nic.TargetSubnetName != nil {
+					targetSubnetName = *nic.TargetSubnetName
+				}
+				if nic.TestSubnetName != nil {
+					testSubnetName = *nic.TestSubnetName
+				}
+			}
+			d.Set("target_subnet_name", targetSubnetName)
+			d.Set("test_subnet_name", testSubnetName)
+			d.Set("target_availability_set_id", details.TargetAvailabilitySetID)
+			d.Set("target_availability_zone", details.TargetAvailabilityZone)
+			d.Set("target_proximity_placement_group_id", details.TargetProximityPlacementGroupID)
+			d.Set("target_boot_diagnostics_storage_account_id", details.TargetBootDiagnosticsStorageAccountID)
+
+			licenseType := string(siterecovery.LicenseTypeNotSpecified)
+			if details.LicenseType != nil && *details.LicenseType != "" {
+				licenseType = *details.LicenseType
+			}
+			d.Set("license_type", licenseType)
+
+			// the disks are only returned individually, so we can only reconcile them when they were specified individually
+			if _, ok := d.GetOk("managed_disk"); ok {
+				if err := d.Set("managed_disk", flattenSiteRecoveryVMWareReplicatedVMDisks(details.ProtectedDisks)); err != nil {
+					return fmt.Errorf("setting `managed_disk`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceSiteRecoveryVMWareReplicatedVMDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	client := meta.(*clients.Client).RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName)
+	fabricName := id.Path["replicationFabrics"]
+	protectionContainerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationProtectedItems"]
+
+	disableProtectionInput := siterecovery.DisableProtectionInput{
+		Properties: &siterecovery.DisableProtectionInputProperties{
+			DisableProtectionReason:  siterecovery.NotSpecified,
+			ReplicationProviderInput: siterecovery.DisableProtectionProviderSpecificInput{},
+		},
+	}
+
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	future, err := client.Delete(ctx, fabricName, protectionContainerName, name, disableProtectionInput)
+	if err != nil {
+		return fmt.Errorf("deleting site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of site recovery vmware replicated vm %s (vault %s): %+v", name, vaultName, err)
+	}
+
+	return nil
+}
+
+func siteRecoveryVMWareReplicatedVMDiskTypes() []string {
+	return []string{
+		string(siterecovery.StandardLRS),
+		string(siterecovery.PremiumLRS),
+		string(siterecovery.StandardSSDLRS),
+	}
+}
+
+func expandSiteRecoveryVMWareReplicatedVMDisks(input []interface{}) *[]siterecovery.InMageRcmDiskInput {
+	disks := make([]siterecovery.InMageRcmDiskInput, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		disk := siterecovery.InMageRcmDiskInput{
+			DiskID:              utils.String(v["disk_id"].(string)),
+			LogStorageAccountID: utils.String(v["log_storage_account_id"].(string)),
+			DiskType:            siterecovery.DiskAccountType(v["target_disk_type"].(string)),
+		}
+
+		if encryptionSetId := v["target_disk_encryption_set_id"].(string); encryptionSetId != "" {
+			disk.DiskEncryptionSetID = utils.String(encryptionSetId)
+		}
+
+		disks = append(disks, disk)
+	}
+
+	return &disks
+}
+
+func flattenSiteRecoveryVMWareReplicatedVMDisks(input *[]siterecovery.InMageRcmProtectedDiskDetails) *pluginsdk.Set {
+	output := make([]interface{}, 0)
+
+	if input != nil {
+		for _, disk := range *input {
+			diskId := ""
+			if disk.DiskID != nil {
+				diskId = *disk.DiskID
+			}
+
+			logStorageAccountId := ""
+			if disk.LogStorageAccountID != nil {
+				logStorageAccountId = *disk.LogStorageAccountID
+			}
+
+			diskEncryptionSetId := ""
+			if disk.DiskEncryptionSetID != nil {
+				diskEncryptionSetId = *disk.DiskEncryptionSetID
+			}
+
+			output = append(output, map[string]interface{}{
+				"disk_id":                       diskId,
+				"log_storage_account_id":        logStorageAccountId,
+				"target_disk_type":              string(disk.DiskType),
+				"target_disk_encryption_set_id": diskEncryptionSetId,
+			})
+		}
+	}
+
+	return pluginsdk.NewSet(resourceSiteRecoveryVMWareReplicatedVMDiskHash, output)
+}
+
+func resourceSiteRecoveryVMWareReplicatedVMDiskHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		if v, ok := m["disk_id"]; ok {
+			buf.WriteString(strings.ToLower(v.(string)))
+		}
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
+func parseSiteRecoveryVMWareReplicatedVMID(input string) (*azure.ResourceID, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, segment := range []string{"vaults", "replicationFabrics", "replicationProtectionContainers", "replicationProtectedItems"} {
+		if _, err := id.PopSegment(segment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// findSiteRecoveryVMWareReplicatedVMPrimaryNic returns the primary NIC, falling back to the first NIC when none is flagged
+func findSiteRecoveryVMWareReplicatedVMPrimaryNic(input *[]siterecovery.InMageRcmNicDetails) *siterecovery.InMageRcmNicDetails {
+	if input == nil || len(*input) == 0 {
+		return nil
+	}
+
+	for _, nic := range *input {
+		if nic.IsPrimaryNic != nil && strings.EqualFold(*nic.IsPrimaryNic, "true") {
+			nic := nic
+			return &nic
+		}
+	}
+
+	return &(*input)[0]
+}
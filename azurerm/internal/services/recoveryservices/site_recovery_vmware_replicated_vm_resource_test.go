@@ -0,0 +1,246 @@
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SiteRecoveryVMWareReplicatedVmResource struct {
+	resourceGroupName       string
+	vaultName               string
+	fabricName              string
+	protectionContainerName string
+	policyId                string
+	discoveryMachineId      string
+	processServerId         string
+}
+
+// these tests require a Recovery Services Vault with a registered VMware replication appliance, which can't be
+// provisioned from Terraform - as such the details of this appliance and a machine it discovered are sourced from
+// the environment
+func newSiteRecoveryVMWareReplicatedVmResource(t *testing.T) SiteRecoveryVMWareReplicatedVmResource {
+	r := SiteRecoveryVMWareReplicatedVmResource{
+		resourceGroupName:       os.Getenv("ARM_TEST_SITE_RECOVERY_RESOURCE_GROUP"),
+		vaultName:               os.Getenv("ARM_TEST_SITE_RECOVERY_VAULT_NAME"),
+		fabricName:              os.Getenv("ARM_TEST_SITE_RECOVERY_FABRIC_NAME"),
+		protectionContainerName: os.Getenv("ARM_TEST_SITE_RECOVERY_PROTECTION_CONTAINER_NAME"),
+		policyId:                os.Getenv("ARM_TEST_SITE_RECOVERY_POLICY_ID"),
+		discoveryMachineId:      os.Getenv("ARM_TEST_SITE_RECOVERY_DISCOVERY_MACHINE_ID"),
+		processServerId:         os.Getenv("ARM_TEST_SITE_RECOVERY_PROCESS_SERVER_ID"),
+	}
+
+	if r.resourceGroupName == "" || r.vaultName == "" || r.fabricName == "" || r.protectionContainerName == "" || r.policyId == "" || r.discoveryMachineId == "" || r.processServerId == "" {
+		t.Skip("Skipping as one of `ARM_TEST_SITE_RECOVERY_RESOURCE_GROUP`, `ARM_TEST_SITE_RECOVERY_VAULT_NAME`, `ARM_TEST_SITE_RECOVERY_FABRIC_NAME`, `ARM_TEST_SITE_RECOVERY_PROTECTION_CONTAINER_NAME`, `ARM_TEST_SITE_RECOVERY_POLICY_ID`, `ARM_TEST_SITE_RECOVERY_DISCOVERY_MACHINE_ID` or `ARM_TEST_SITE_RECOVERY_PROCESS_SERVER_ID` is not set")
+	}
+
+	return r
+}
+
+func TestAccSiteRecoveryVMWareReplicatedVm_basic(t *testing.T) {
+	r := newSiteRecoveryVMWareReplicatedVmResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_vmware_replicated_vm", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_log_storage_account_id", "default_target_disk_type"),
+	})
+}
+
+func TestAccSiteRecoveryVMWareReplicatedVm_update(t *testing.T) {
+	r := newSiteRecoveryVMWareReplicatedVmResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_vmware_replicated_vm", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_log_storage_account_id", "default_target_disk_type"),
+		{
+			Config: r.targetVMSize(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_vm_size").HasValue("Standard_B2s"),
+			),
+		},
+		data.ImportStep("default_log_storage_account_id", "default_target_disk_type"),
+	})
+}
+
+func TestAccSiteRecoveryVMWareReplicatedVm_testNetwork(t *testing.T) {
+	r := newSiteRecoveryVMWareReplicatedVmResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_vmware_replicated_vm", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.testNetwork(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_log_storage_account_id", "default_target_disk_type"),
+	})
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azure.ParseAzureResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["replicationFabrics"]
+	protectionContainerName := id.Path["replicationProtectionContainers"]
+	name := id.Path["replicationProtectedItems"]
+
+	resp, err := clients.RecoveryServices.ReplicationMigrationItemsClient(resGroup, vaultName).Get(ctx, fabricName, protectionContainerName, name)
+	if err != nil {
+		return nil, fmt.Errorf("reading site recovery vmware replicated vm (%s): %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-recovery-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["192.168.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsn-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "192.168.1.0/24"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_site_recovery_vmware_replicated_vm" "test" {
+  name                                      = "acctestvm-%[2]d"
+  resource_group_name                       = "%[3]s"
+  recovery_vault_name                       = "%[4]s"
+  source_recovery_fabric_name               = "%[5]s"
+  source_recovery_protection_container_name = "%[6]s"
+  recovery_replication_policy_id            = "%[7]s"
+  source_vm_discovery_machine_id            = "%[8]s"
+  appliance_process_server_id               = "%[9]s"
+
+  default_log_storage_account_id = azurerm_storage_account.test.id
+  default_target_disk_type       = "Standard_LRS"
+
+  target_resource_group_id = azurerm_resource_group.test.id
+  target_vm_name           = "acctestvm-%[2]d"
+  target_network_id        = azurerm_virtual_network.test.id
+  target_subnet_name       = azurerm_subnet.test.name
+}
+`, r.template(data), data.RandomInteger, r.resourceGroupName, r.vaultName, r.fabricName, r.protectionContainerName, r.policyId, r.discoveryMachineId, r.processServerId)
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) testNetwork(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvn2-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["192.168.2.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_subnet" "test2" {
+  name                 = "acctestsn2-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test2.name
+  address_prefix       = "192.168.2.0/24"
+}
+
+resource "azurerm_site_recovery_vmware_replicated_vm" "test" {
+  name                                      = "acctestvm-%[2]d"
+  resource_group_name                       = "%[3]s"
+  recovery_vault_name                       = "%[4]s"
+  source_recovery_fabric_name               = "%[5]s"
+  source_recovery_protection_container_name = "%[6]s"
+  recovery_replication_policy_id            = "%[7]s"
+  source_vm_discovery_machine_id            = "%[8]s"
+  appliance_process_server_id               = "%[9]s"
+
+  default_log_storage_account_id = azurerm_storage_account.test.id
+  default_target_disk_type       = "Standard_LRS"
+
+  target_resource_group_id = azurerm_resource_group.test.id
+  target_vm_name           = "acctestvm-%[2]d"
+  target_network_id        = azurerm_virtual_network.test.id
+  target_subnet_name       = azurerm_subnet.test.name
+  test_network_id          = azurerm_virtual_network.test2.id
+  test_subnet_name         = azurerm_subnet.test2.name
+}
+`, r.template(data), data.RandomInteger, r.resourceGroupName, r.vaultName, r.fabricName, r.protectionContainerName, r.policyId, r.discoveryMachineId, r.processServerId)
+}
+
+func (r SiteRecoveryVMWareReplicatedVmResource) targetVMSize(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_site_recovery_vmware_replicated_vm" "test" {
+  name                                      = "acctestvm-%[2]d"
+  resource_group_name                       = "%[3]s"
+  recovery_vault_name                       = "%[4]s"
+  source_recovery_fabric_name               = "%[5]s"
+  source_recovery_protection_container_name = "%[6]s"
+  recovery_replication_policy_id            = "%[7]s"
+  source_vm_discovery_machine_id            = "%[8]s"
+  appliance_process_server_id               = "%[9]s"
+
+  default_log_storage_account_id = azurerm_storage_account.test.id
+  default_target_disk_type       = "Standard_LRS"
+
+  target_resource_group_id = azurerm_resource_group.test.id
+  target_vm_name           = "acctestvm-%[2]d"
+  target_vm_size           = "Standard_B2s"
+  target_network_id        = azurerm_virtual_network.test.id
+  target_subnet_name       = azurerm_subnet.test.name
+}
+`, r.template(data), data.RandomInteger, r.resourceGroupName, r.vaultName, r.fabricName, r.protectionContainerName, r.policyId, r.discoveryMachineId, r.processServerId)
+}
@@ -58,6 +58,22 @@ func resourceRelayNamespace() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"primary_key_rotation_trigger": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"secondary_key_rotation_trigger": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"metric_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -137,6 +153,27 @@ func resourceRelayNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("waiting for create/update of Relay Namespace %q (Resource Group %q) creation: %+v", resourceId.Name, resourceId.ResourceGroup, err)
 	}
 
+	if !d.IsNewResource() {
+		// the keys are regenerated whenever the associated triggers change, which allows them to be rotated in-place
+		keyTriggers := map[relay.KeyType]string{
+			relay.PrimaryKey:   "primary_key_rotation_trigger",
+			relay.SecondaryKey: "secondary_key_rotation_trigger",
+		}
+		for _, keyType := range relay.PossibleKeyTypeValues() {
+			if !d.HasChange(keyTriggers[keyType]) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Regenerating the %s for Relay Namespace %q (Resource Group %q)..", string(keyType), resourceId.Name, resourceId.ResourceGroup)
+			regenerateParameters := relay.RegenerateAccessKeyParameters{
+				KeyType: keyType,
+			}
+			if _, err := client.RegenerateKeys(ctx, resourceId.ResourceGroup, resourceId.Name, "RootManageSharedAccessKey", regenerateParameters); err != nil {
+				return fmt.Errorf("regenerating the %s for Relay Namespace %q (Resource Group %q): %+v", string(keyType), resourceId.Name, resourceId.ResourceGroup, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 	return resourceRelayNamespaceRead(d, meta)
 }
@@ -1,8 +1,10 @@
 package securitycenter
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -190,7 +192,94 @@ func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(securityCenterAutomationCustomizeDiff),
+	}
+}
+
+func securityCenterAutomationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for i, actionRaw := range d.Get("action").([]interface{}) {
+		action, ok := actionRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch strings.ToLower(action["type"].(string)) {
+		case typeLogicApp:
+			key := fmt.Sprintf("action.%d.trigger_url", i)
+			if d.NewValueKnown(key) && action["trigger_url"].(string) == "" {
+				return fmt.Errorf("`trigger_url` must be specified for `action.%d` when `type` is `LogicApp`", i)
+			}
+		case typeEventHub:
+			key := fmt.Sprintf("action.%d.connection_string", i)
+			if d.NewValueKnown(key) && action["connection_string"].(string) == "" {
+				return fmt.Errorf("`connection_string` must be specified for `action.%d` when `type` is `EventHub`", i)
+			}
+		}
+	}
+
+	for i, sourceRaw := range d.Get("source").([]interface{}) {
+		source, ok := sourceRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for j, ruleSetRaw := range source["rule_set"].([]interface{}) {
+			ruleSet, ok := ruleSetRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			for k, ruleRaw := range ruleSet["rule"].([]interface{}) {
+				rule, ok := ruleRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if !d.NewValueKnown(fmt.Sprintf("source.%d.rule_set.%d.rule.%d.expected_value", i, j, k)) {
+					continue
+				}
+
+				if err := validateSecurityCenterAutomationRule(rule["property_type"].(string), rule["operator"].(string), rule["expected_value"].(string)); err != nil {
+					return fmt.Errorf("`source.%d.rule_set.%d.rule.%d`: %+v", i, j, k, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSecurityCenterAutomationRule checks the operator and the expected value are compatible with the property type
+func validateSecurityCenterAutomationRule(propertyType, operator, expectedValue string) error {
+	switch {
+	case strings.EqualFold(propertyType, string(security.Integer)):
+		if _, err := strconv.ParseInt(expectedValue, 10, 64); err != nil {
+			return fmt.Errorf("`expected_value` must be an integer when `property_type` is %q, got %q", propertyType, expectedValue)
+		}
+	case strings.EqualFold(propertyType, string(security.Number)):
+		if _, err := strconv.ParseFloat(expectedValue, 64); err != nil {
+			return fmt.Errorf("`expected_value` must be a number when `property_type` is %q, got %q", propertyType, expectedValue)
+		}
+	case strings.EqualFold(propertyType, string(security.Boolean)):
+		if _, err := strconv.ParseBool(expectedValue); err != nil {
+			return fmt.Errorf("`expected_value` must be a boolean when `property_type` is %q, got %q", propertyType, expectedValue)
+		}
+	}
+
+	isNumeric := strings.EqualFold(propertyType, string(security.Integer)) || strings.EqualFold(propertyType, string(security.Number))
+	switch {
+	case strings.EqualFold(operator, string(security.Contains)), strings.EqualFold(operator, string(security.StartsWith)), strings.EqualFold(operator, string(security.EndsWith)):
+		if !strings.EqualFold(propertyType, string(security.String)) {
+			return fmt.Errorf("`operator` %q can only be used when `property_type` is %q", operator, string(security.String))
+		}
+	case strings.EqualFold(operator, string(security.GreaterThan)), strings.EqualFold(operator, string(security.GreaterThanOrEqualTo)), strings.EqualFold(operator, string(security.LesserThan)), strings.EqualFold(operator, string(security.LesserThanOrEqualTo)):
+		if !isNumeric {
+			return fmt.Errorf("`operator` %q can only be used when `property_type` is %q or %q", operator, string(security.Integer), string(security.Number))
+		}
 	}
+
+	return nil
 }
 
 func resourceSecurityCenterAutomationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -3,6 +3,7 @@ package securitycenter_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -121,6 +122,18 @@ func TestAccSecurityCenterAutomation_ruleSingle(t *testing.T) {
 	})
 }
 
+func TestAccSecurityCenterAutomation_ruleInvalidValue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_security_center_automation", "test")
+	r := SecurityCenterAutomationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.ruleInvalidValue(data),
+			ExpectError: regexp.MustCompile("`expected_value` must be an integer"),
+		},
+	})
+}
+
 func TestAccSecurityCenterAutomation_ruleMulti(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_security_center_automation", "test")
 	r := SecurityCenterAutomationResource{}
@@ -449,6 +462,56 @@ resource "azurerm_security_center_automation" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary)
 }
 
+func (SecurityCenterAutomationResource) ruleInvalidValue(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_security_center_automation" "test" {
+  name                = "acctestautomation-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  scopes = [
+    "/subscriptions/${data.azurerm_client_config.current.subscription_id}"
+  ]
+
+  action {
+    type        = "LogAnalytics"
+    resource_id = azurerm_log_analytics_workspace.test.id
+  }
+
+  source {
+    event_source = "SecureScores"
+    rule_set {
+      rule {
+        property_path  = "properties.score.current"
+        operator       = "GreaterThan"
+        expected_value = "High"
+        property_type  = "Integer"
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (SecurityCenterAutomationResource) scopeMulti(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,180 @@
+package sentinel
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2019-01-01-preview/securityinsight"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	loganalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const sentinelUebaSettingName = "Ueba"
+
+func resourceSentinelUebaSetting() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSentinelUebaSettingCreateUpdate,
+		Read:   resourceSentinelUebaSettingRead,
+		Update: resourceSentinelUebaSettingCreateUpdate,
+		Delete: resourceSentinelUebaSettingDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SettingID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"data_sources": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(securityinsight.UebaDataSourcesAuditLogs),
+						string(securityinsight.UebaDataSourcesAzureActivity),
+						string(securityinsight.UebaDataSourcesSecurityEvent),
+						string(securityinsight.UebaDataSourcesSigninLogs),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceSentinelUebaSettingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.ProductSettingsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(d.Get("log_analytics_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewSettingID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, sentinelUebaSettingName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	var etag *string
+	if !utils.ResponseWasNotFound(resp.Response) {
+		existing, ok := resp.Value.(securityinsight.Ueba)
+		if !ok {
+			return fmt.Errorf("%s was not a UEBA Setting", id)
+		}
+
+		// the setting is always present once Sentinel is onboarded, so it's only considered to
+		// be managed elsewhere when data sources have already been configured
+		if d.IsNewResource() && existing.UebaProperties != nil && existing.DataSources != nil && len(*existing.DataSources) > 0 {
+			return tf.ImportAsExistsError("azurerm_sentinel_ueba_setting", id.ID())
+		}
+
+		etag = existing.Etag
+	}
+
+	dataSources := make([]securityinsight.UebaDataSources, 0)
+	for _, v := range d.Get("data_sources").(*pluginsdk.Set).List() {
+		dataSources = append(dataSources, securityinsight.UebaDataSources(v.(string)))
+	}
+
+	param := securityinsight.Ueba{
+		Etag: etag,
+		UebaProperties: &securityinsight.UebaProperties{
+			DataSources: &dataSources,
+		},
+		Kind: securityinsight.KindBasicSettingsKindUeba,
+	}
+
+	if _, err = client.Update(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name, param); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSentinelUebaSettingRead(d, meta)
+}
+
+func resourceSentinelUebaSettingRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.ProductSettingsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SettingID(d.Id())
+	if err != nil {
+		return err
+	}
+	workspaceId := loganalyticsParse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	setting, ok := resp.Value.(securityinsight.Ueba)
+	if !ok {
+		return fmt.Errorf("%s was not a UEBA Setting", id)
+	}
+
+	d.Set("log_analytics_workspace_id", workspaceId.ID())
+
+	dataSources := make([]interface{}, 0)
+	if props := setting.UebaProperties; props != nil && props.DataSources != nil {
+		for _, v := range *props.DataSources {
+			dataSources = append(dataSources, string(v))
+		}
+	}
+	if err := d.Set("data_sources", dataSources); err != nil {
+		return fmt.Errorf("setting `data_sources`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceSentinelUebaSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sentinel.ProductSettingsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SettingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(ctx, id.ResourceGroup, OperationalInsightsResourceProvider, id.WorkspaceName, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
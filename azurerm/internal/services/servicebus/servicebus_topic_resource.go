@@ -1,6 +1,7 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -121,6 +122,8 @@ func resourceServiceBusTopic() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(serviceBusTopicCustomizeDiff),
 	}
 }
 
@@ -154,6 +157,18 @@ func resourceServiceBusTopicCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	// the Namespace may not have existed at plan time, so we need to check the SKU again here
+	namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
+	namespace, err := namespacesClient.Get(ctx, resourceId.ResourceGroup, resourceId.NamespaceName)
+	if err != nil {
+		return fmt.Errorf("retrieving ServiceBus Namespace %q (Resource Group %q): %+v", resourceId.NamespaceName, resourceId.ResourceGroup, err)
+	}
+	if namespace.Sku != nil && (d.IsNewResource() || d.HasChange("enable_partitioning") || d.HasChange("enable_express")) {
+		if err := validateServiceBusTopicForNamespaceSku(namespace.Sku.Name, enablePartitioning, enableExpress); err != nil {
+			return fmt.Errorf("ServiceBus Topic %q: %+v", resourceId.Name, err)
+		}
+	}
+
 	parameters := servicebus.SBTopic{
 		Name: utils.String(resourceId.Name),
 		SBTopicProperties: &servicebus.SBTopicProperties{
@@ -250,6 +265,60 @@ func resourceServiceBusTopicRead(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+func serviceBusTopicCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	enablePartitioning := d.Get("enable_partitioning").(bool)
+	enableExpress := d.Get("enable_express").(bool)
+
+	// previously existing partitioned entities in Premium namespaces continue to work, so we only
+	// check these against the Namespace SKU when they're being set
+	if d.Id() != "" && !d.HasChange("enable_partitioning") && !d.HasChange("enable_express") {
+		return nil
+	}
+
+	if !enablePartitioning && !enableExpress {
+		return nil
+	}
+
+	// the Namespace name may not be known until apply time, or the Namespace may not exist yet - in
+	// which case this is checked again during Create/Update
+	if !d.NewValueKnown("namespace_name") || !d.NewValueKnown("resource_group_name") {
+		return nil
+	}
+
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	namespaceName := d.Get("namespace_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespace, err := client.Get(ctx, resourceGroup, namespaceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(namespace.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving ServiceBus Namespace %q (Resource Group %q): %+v", namespaceName, resourceGroup, err)
+	}
+
+	if namespace.Sku == nil {
+		return nil
+	}
+
+	return validateServiceBusTopicForNamespaceSku(namespace.Sku.Name, enablePartitioning, enableExpress)
+}
+
+func validateServiceBusTopicForNamespaceSku(sku servicebus.SkuName, enablePartitioning, enableExpress bool) error {
+	switch sku {
+	case servicebus.Basic:
+		return fmt.Errorf("Topics are not supported by ServiceBus Namespaces with the SKU %q", string(sku))
+	case servicebus.Premium:
+		if enablePartitioning {
+			return fmt.Errorf("`enable_partitioning` is not supported by ServiceBus Namespaces with the SKU %q", string(sku))
+		}
+		if enableExpress {
+			return fmt.Errorf("`enable_express` is not supported by ServiceBus Namespaces with the SKU %q", string(sku))
+		}
+	}
+
+	return nil
+}
+
 func resourceServiceBusTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ServiceBus.TopicsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
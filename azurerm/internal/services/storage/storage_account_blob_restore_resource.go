@@ -0,0 +1,235 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountBlobRestore() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountBlobRestoreCreate,
+		Read:   resourceStorageAccountBlobRestoreRead,
+		Delete: resourceStorageAccountBlobRestoreDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BlobRestoreID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"time_to_restore": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"blob_range": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// an empty start or end range means the start or end of the account respectively
+						"start_range": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"end_range": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountBlobRestoreCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(storageAccountId.Name, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountId.Name, storageAccountResourceName)
+
+	timeToRestore, err := date.ParseTime(time.RFC3339, d.Get("time_to_restore").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `time_to_restore`: %+v", err)
+	}
+
+	parameters := storage.BlobRestoreParameters{
+		TimeToRestore: &date.Time{Time: timeToRestore},
+		BlobRanges:    expandStorageAccountBlobRestoreRanges(d.Get("blob_range").([]interface{})),
+	}
+
+	future, err := client.RestoreBlobRanges(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("restoring blob ranges for %s: %+v", storageAccountId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the restore of blob ranges for %s: %+v", storageAccountId, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the result of the blob ranges restore for %s: %+v", storageAccountId, err)
+	}
+
+	if result.Status == storage.Failed {
+		failureReason := ""
+		if result.FailureReason != nil {
+			failureReason = *result.FailureReason
+		}
+		return fmt.Errorf("restoring blob ranges for %s failed: %s", storageAccountId, failureReason)
+	}
+
+	if result.RestoreID == nil || *result.RestoreID == "" {
+		return fmt.Errorf("restoring blob ranges for %s: `restoreId` was nil", storageAccountId)
+	}
+
+	id := parse.NewBlobRestoreID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, *result.RestoreID)
+	d.SetId(id.ID())
+
+	return resourceStorageAccountBlobRestoreRead(d, meta)
+}
+
+func resourceStorageAccountBlobRestoreRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BlobRestoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, storage.AccountExpandBlobRestoreStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Storage Account %q (Resource Group %q) was not found - removing %s from state", id.StorageAccountName, id.ResourceGroup, id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", id.StorageAccountName, id.ResourceGroup, err)
+	}
+
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	// the Storage Account only reports the status of the most recent restore, so once another
+	// restore has been triggered the details of this one are retained from the state
+	if props := resp.AccountProperties; props != nil && props.BlobRestoreStatus != nil {
+		status := props.BlobRestoreStatus
+		if status.RestoreID != nil && *status.RestoreID == id.Name {
+			d.Set("status", string(status.Status))
+
+			if params := status.Parameters; params != nil {
+				if params.TimeToRestore != nil {
+					d.Set("time_to_restore", params.TimeToRestore.Format(time.RFC3339))
+				}
+				if err := d.Set("blob_range", flattenStorageAccountBlobRestoreRanges(params.BlobRanges)); err != nil {
+					return fmt.Errorf("setting `blob_range`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountBlobRestoreDelete(_ *pluginsdk.ResourceData, _ interface{}) error {
+	// a restore can't be undone, so there is nothing to delete
+	return nil
+}
+
+func expandStorageAccountBlobRestoreRanges(input []interface{}) *[]storage.BlobRestoreRange {
+	ranges := make([]storage.BlobRestoreRange, 0)
+
+	for _, item := range input {
+		// both ranges are optional, so the block can be entirely empty to restore the whole account
+		if item == nil {
+			ranges = append(ranges, storage.BlobRestoreRange{
+				StartRange: utils.String(""),
+				EndRange:   utils.String(""),
+			})
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		ranges = append(ranges, storage.BlobRestoreRange{
+			StartRange: utils.String(v["start_range"].(string)),
+			EndRange:   utils.String(v["end_range"].(string)),
+		})
+	}
+
+	return &ranges
+}
+
+func flattenStorageAccountBlobRestoreRanges(input *[]storage.BlobRestoreRange) []interface{} {
+	ranges := make([]interface{}, 0)
+	if input == nil {
+		return ranges
+	}
+
+	for _, item := range *input {
+		startRange := ""
+		if item.StartRange != nil {
+			startRange = *item.StartRange
+		}
+
+		endRange := ""
+		if item.EndRange != nil {
+			endRange = *item.EndRange
+		}
+
+		ranges = append(ranges, map[string]interface{}{
+			"start_range": startRange,
+			"end_range":   endRange,
+		})
+	}
+
+	return ranges
+}
@@ -0,0 +1,119 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type StorageAccountBlobRestoreResource struct{}
+
+func TestAccStorageAccountBlobRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_blob_restore", "test")
+	r := StorageAccountBlobRestoreResource{}
+	timeToRestore := time.Now().UTC().Add(5 * time.Minute)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			// the restore point needs to be in the past, but after the restore policy was enabled
+			PreConfig: func() { time.Sleep(time.Until(timeToRestore.Add(time.Minute))) },
+			Config:    r.basic(data, timeToRestore),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Complete"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountBlobRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BlobRestoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, storage.AccountExpandBlobRestoreStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Storage Account %q (Resource Group %q): %+v", id.StorageAccountName, id.ResourceGroup, err)
+	}
+
+	if props := resp.AccountProperties; props != nil && props.BlobRestoreStatus != nil {
+		if restoreId := props.BlobRestoreStatus.RestoreID; restoreId != nil && *restoreId == id.Name {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r StorageAccountBlobRestoreResource) basic(data acceptance.TestData, timeToRestore time.Time) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_blob_restore" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  time_to_restore    = "%s"
+
+  blob_range {
+    start_range = "${azurerm_storage_container.test.name}/"
+    end_range   = "${azurerm_storage_container.test.name}0/"
+  }
+}
+`, r.template(data), timeToRestore.Format(time.RFC3339))
+}
+
+func (r StorageAccountBlobRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+
+    delete_retention_policy {
+      days = 7
+    }
+
+    restore_policy {
+      days = 6
+    }
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
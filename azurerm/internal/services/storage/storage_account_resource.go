@@ -377,6 +377,22 @@ func resourceStorageAccount() *pluginsdk.Resource {
 							},
 						},
 
+						"restore_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"days": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+							RequiredWith: []string{"blob_properties.0.delete_retention_policy"},
+						},
+
 						"versioning_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -858,6 +874,13 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 			}
 
+			// blobs can only be restored whilst they're still soft deleted, so the restore window has to be shorter than the retention period
+			if restoreDays, ok := d.GetOk("blob_properties.0.restore_policy.0.days"); ok {
+				if deleteDays, ok := d.GetOk("blob_properties.0.delete_retention_policy.0.days"); ok && restoreDays.(int) >= deleteDays.(int) {
+					return fmt.Errorf("`blob_properties.0.restore_policy.0.days` (%d) must be less than `blob_properties.0.delete_retention_policy.0.days` (%d)", restoreDays.(int), deleteDays.(int))
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -2004,6 +2027,9 @@ func expandBlobProperties(input []interface{}) *storage.BlobServiceProperties {
 	corsRaw := v["cors_rule"].([]interface{})
 	props.BlobServicePropertiesProperties.Cors = expandBlobPropertiesCors(corsRaw)
 
+	restorePolicyRaw := v["restore_policy"].([]interface{})
+	props.BlobServicePropertiesProperties.RestorePolicy = expandBlobPropertiesRestorePolicy(restorePolicyRaw)
+
 	props.IsVersioningEnabled = utils.Bool(v["versioning_enabled"].(bool))
 
 	props.ChangeFeed = &storage.ChangeFeed{
@@ -2037,6 +2063,22 @@ func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}, isupdate boo
 	}
 }
 
+func expandBlobPropertiesRestorePolicy(input []interface{}) *storage.RestorePolicyProperties {
+	result := storage.RestorePolicyProperties{
+		Enabled: utils.Bool(false),
+	}
+	if len(input) == 0 || input[0] == nil {
+		return &result
+	}
+
+	policy := input[0].(map[string]interface{})
+
+	return &storage.RestorePolicyProperties{
+		Enabled: utils.Bool(true),
+		Days:    utils.Int32(int32(policy["days"].(int))),
+	}
+}
+
 func expandBlobPropertiesCors(input []interface{}) *storage.CorsRules {
 	blobCorsRules := storage.CorsRules{}
 
@@ -2432,6 +2474,11 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		flattenedDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(deletePolicy)
 	}
 
+	flattenedRestorePolicy := make([]interface{}, 0)
+	if restorePolicy := input.BlobServicePropertiesProperties.RestorePolicy; restorePolicy != nil {
+		flattenedRestorePolicy = flattenBlobPropertiesRestorePolicy(restorePolicy)
+	}
+
 	flattenedContainerDeletePolicy := make([]interface{}, 0)
 	if containerDeletePolicy := input.BlobServicePropertiesProperties.ContainerDeleteRetentionPolicy; containerDeletePolicy != nil {
 		flattenedContainerDeletePolicy = flattenBlobPropertiesDeleteRetentionPolicy(containerDeletePolicy)
@@ -2460,6 +2507,7 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		map[string]interface{}{
 			"cors_rule":                         flattenedCorsRules,
 			"delete_retention_policy":           flattenedDeletePolicy,
+			"restore_policy":                    flattenedRestorePolicy,
 			"versioning_enabled":                versioning,
 			"change_feed_enabled":               changeFeed,
 			"default_service_version":           defaultServiceVersion,
@@ -2535,6 +2583,27 @@ func flattenBlobPropertiesDeleteRetentionPolicy(input *storage.DeleteRetentionPo
 	return deleteRetentionPolicy
 }
 
+func flattenBlobPropertiesRestorePolicy(input *storage.RestorePolicyProperties) []interface{} {
+	restorePolicy := make([]interface{}, 0)
+
+	if input == nil {
+		return restorePolicy
+	}
+
+	if enabled := input.Enabled; enabled != nil && *enabled {
+		days := 0
+		if input.Days != nil {
+			days = int(*input.Days)
+		}
+
+		restorePolicy = append(restorePolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return restorePolicy
+}
+
 func flattenQueueProperties(input *queues.StorageServiceProperties) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -665,6 +665,42 @@ func TestAccStorageAccount_blobProperties_containerAndLastAccessTimeDisabled(t *
 	})
 }
 
+func TestAccStorageAccount_blobPropertiesRestorePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
+	r := StorageAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blobPropertiesRestorePolicy(data, 6),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blob_properties.0.restore_policy.0.days").HasValue("6"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.blobPropertiesRestorePolicy(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blob_properties.0.restore_policy.0.days").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.blobPropertiesUpdated2(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blob_properties.0.restore_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.blobPropertiesRestorePolicy(data, 7),
+			ExpectError: regexp.MustCompile("must be less than `blob_properties.0.delete_retention_policy.0.days`"),
+		},
+	})
+}
+
 func TestAccStorageAccount_blobPropertiesEmptyAllowedExposedHeaders(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account", "test")
 	r := StorageAccountResource{}
@@ -2130,6 +2166,41 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) blobPropertiesRestorePolicy(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestAzureRMSA-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+
+    delete_retention_policy {
+      days = 7
+    }
+
+    restore_policy {
+      days = %d
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, days)
+}
+
 func (r StorageAccountResource) blobPropertiesUpdated2(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
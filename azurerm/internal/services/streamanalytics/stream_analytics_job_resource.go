@@ -1,6 +1,7 @@
 package streamanalytics
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -149,7 +150,29 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStreamAnalyticsJobCustomizeDiff),
+	}
+}
+
+func resourceStreamAnalyticsJobCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("events_late_arrival_max_delay_in_seconds") || !d.NewValueKnown("events_out_of_order_max_delay_in_seconds") {
+		return nil
 	}
+
+	// the Stream Analytics service only re-orders events within the late arrival tolerance window, so (unless late events
+	// are waited for indefinitely) it can't be shorter than the out-of-order tolerance window, see:
+	// https://docs.microsoft.com/azure/stream-analytics/stream-analytics-time-handling
+	//
+	// NOTE: this rejects configurations which were previously accepted by the provider, such as an out of order delay
+	// of 10 seconds combined with the default late arrival delay of 5 seconds
+	lateArrivalMaxDelay := d.Get("events_late_arrival_max_delay_in_seconds").(int)
+	outOfOrderMaxDelay := d.Get("events_out_of_order_max_delay_in_seconds").(int)
+	if lateArrivalMaxDelay != -1 && lateArrivalMaxDelay < outOfOrderMaxDelay {
+		return fmt.Errorf("`events_late_arrival_max_delay_in_seconds` (%d) must be -1 or greater than or equal to `events_out_of_order_max_delay_in_seconds` (%d)", lateArrivalMaxDelay, outOfOrderMaxDelay)
+	}
+
+	return nil
 }
 
 func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
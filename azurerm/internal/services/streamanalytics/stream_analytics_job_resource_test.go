@@ -3,6 +3,7 @@ package streamanalytics_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -101,6 +102,34 @@ func TestAccStreamAnalyticsJob_identity(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsJob_eventsLateArrivalIndefinite(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job", "test")
+	r := StreamAnalyticsJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.eventsLateArrivalIndefinite(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("events_late_arrival_max_delay_in_seconds").HasValue("-1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStreamAnalyticsJob_eventsLateArrivalShorterThanOutOfOrder(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job", "test")
+	r := StreamAnalyticsJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.eventsDelays(data, 5, 60),
+			ExpectError: regexp.MustCompile("`events_late_arrival_max_delay_in_seconds` \\(5\\) must be -1 or greater than or equal to"),
+		},
+	})
+}
+
 func (r StreamAnalyticsJobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	name := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
@@ -267,3 +296,36 @@ QUERY
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (r StreamAnalyticsJobResource) eventsLateArrivalIndefinite(data acceptance.TestData) string {
+	return r.eventsDelays(data, -1, 599)
+}
+
+func (r StreamAnalyticsJobResource) eventsDelays(data acceptance.TestData, lateArrivalMaxDelay, outOfOrderMaxDelay int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                                     = "acctestjob-%d"
+  resource_group_name                      = azurerm_resource_group.test.name
+  location                                 = azurerm_resource_group.test.location
+  streaming_units                          = 3
+  events_late_arrival_max_delay_in_seconds = %d
+  events_out_of_order_max_delay_in_seconds = %d
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, lateArrivalMaxDelay, outOfOrderMaxDelay)
+}